@@ -0,0 +1,71 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"net/http"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// BasicAuthenticator adds basic authentication credentials
+// obtained from a CredentialProvider to each request.
+// Unlike core.BasicAuthenticator the credentials are fetched for every request,
+// so rotated credentials are used without rebuilding the client.
+type BasicAuthenticator struct {
+	// [Required] The provider of the username and password.
+	CredentialProvider CredentialProvider
+}
+
+// NewBasicAuthenticatorWithProvider constructs a new BasicAuthenticator instance.
+func NewBasicAuthenticatorWithProvider(provider CredentialProvider) (*BasicAuthenticator, error) {
+	authenticator := &BasicAuthenticator{
+		CredentialProvider: provider,
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator.
+func (a *BasicAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_BASIC
+}
+
+// Validate the authenticator's configuration.
+// Ensures the credential provider is set.
+func (a *BasicAuthenticator) Validate() error {
+	if a.CredentialProvider == nil {
+		return core.SDKErrorf(nil, "CredentialProvider must be set", "no-credential-provider", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Authenticate adds basic authentication credentials to a request.
+func (a *BasicAuthenticator) Authenticate(request *http.Request) error {
+	credentials, err := a.CredentialProvider.GetCredentials(request.Context())
+	if err != nil {
+		return core.SDKErrorf(err, "", "auth-credentials-fail", common.GetComponentInfo())
+	}
+	if err := credentials.Validate(); err != nil {
+		return err
+	}
+	request.SetBasicAuth(credentials.Username, credentials.Password)
+	return nil
+}
@@ -0,0 +1,362 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	AUTHTYPE_COUCHDB_JWT = "COUCHDB_JWT"
+)
+
+// Configuration property names used by NewCouchDbJwtAuthenticatorFromMap.
+const (
+	PROPNAME_JWT_ALGORITHM  = "JWT_ALGORITHM"
+	PROPNAME_JWT_KEY        = "JWT_KEY"
+	PROPNAME_JWT_KEY_FILE   = "JWT_KEY_FILE"
+	PROPNAME_JWT_KEY_ID     = "JWT_KEY_ID"
+	PROPNAME_JWT_ROLES      = "JWT_ROLES"
+	PROPNAME_JWT_EXPIRATION = "JWT_EXPIRATION"
+)
+
+// Signing algorithms supported by CouchDbJwtAuthenticator.
+const (
+	JwtAlgorithmHS256 = "HS256"
+	JwtAlgorithmHS384 = "HS384"
+	JwtAlgorithmHS512 = "HS512"
+	JwtAlgorithmRS256 = "RS256"
+	JwtAlgorithmRS384 = "RS384"
+	JwtAlgorithmRS512 = "RS512"
+)
+
+// DefaultJwtExpiration is the lifetime of tokens signed by
+// CouchDbJwtAuthenticator when Expiration is not set.
+const DefaultJwtExpiration = 5 * time.Minute
+
+// CouchDbJwtAuthenticator signs JSON Web Tokens locally with a HMAC secret
+// or a RSA private key and adds them to requests as a bearer token.
+// This matches CouchDB's JWT authentication handler configured with the
+// same key in its [jwt_keys] section.
+//
+// Tokens are reused until 80% of their lifetime has passed,
+// after which a new token is signed for the next request.
+type CouchDbJwtAuthenticator struct {
+	// [Required] The user name set as the "sub" claim.
+	Subject string
+
+	// [Optional] The CouchDB roles set as the "_couchdb.roles" claim.
+	Roles []string
+
+	// [Required] The signing algorithm, one of HS256, HS384, HS512, RS256, RS384 or RS512.
+	Algorithm string
+
+	// [Required] The HMAC secret or the PEM encoded RSA private key.
+	Key []byte
+
+	// [Optional] The key identifier set as the "kid" header.
+	KeyID string
+
+	// [Optional] The lifetime of a signed token, defaults to DefaultJwtExpiration.
+	Expiration time.Duration
+
+	// Parsed RSA private key for RS* algorithms and a copy of the Key
+	// it was validated from, to re-validate when the Key is changed.
+	rsaKey       *rsa.PrivateKey
+	validatedKey []byte
+
+	// The currently cached token and its refresh time.
+	token       string
+	refreshTime time.Time
+
+	// Authenticator mutex used to make token rotation thread-safe.
+	mu sync.Mutex
+}
+
+// NewCouchDbJwtAuthenticator constructs a new CouchDbJwtAuthenticator instance.
+func NewCouchDbJwtAuthenticator(subject, algorithm string, key []byte) (*CouchDbJwtAuthenticator, error) {
+	authenticator := &CouchDbJwtAuthenticator{
+		Subject:   subject,
+		Algorithm: algorithm,
+		Key:       key,
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// NewCouchDbJwtAuthenticatorFromMap constructs a new CouchDbJwtAuthenticator instance from a map.
+func NewCouchDbJwtAuthenticatorFromMap(props map[string]string) (*CouchDbJwtAuthenticator, error) {
+	if props == nil {
+		return nil, core.SDKErrorf(nil, core.ERRORMSG_PROPS_MAP_NIL, "missing-props", common.GetComponentInfo())
+	}
+
+	key := []byte(props[PROPNAME_JWT_KEY])
+	if keyFile, ok := props[PROPNAME_JWT_KEY_FILE]; ok && keyFile != "" {
+		if len(key) > 0 {
+			err := fmt.Errorf(core.ERRORMSG_EXCLUSIVE_PROPS_ERROR, PROPNAME_JWT_KEY, PROPNAME_JWT_KEY_FILE)
+			return nil, core.SDKErrorf(err, "", "jwt-key-exclusive", common.GetComponentInfo())
+		}
+		var err error
+		key, err = os.ReadFile(keyFile) // #nosec G304
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "jwt-key-file-read", common.GetComponentInfo())
+		}
+	}
+
+	algorithm := props[PROPNAME_JWT_ALGORITHM]
+	if algorithm == "" {
+		algorithm = JwtAlgorithmHS256
+	}
+
+	authenticator := &CouchDbJwtAuthenticator{
+		Subject:   props[core.PROPNAME_USERNAME],
+		Algorithm: strings.ToUpper(algorithm),
+		Key:       key,
+		KeyID:     props[PROPNAME_JWT_KEY_ID],
+		Roles:     splitList(props[PROPNAME_JWT_ROLES]),
+	}
+
+	if expiration, ok := props[PROPNAME_JWT_EXPIRATION]; ok && expiration != "" {
+		d, err := time.ParseDuration(expiration)
+		if err != nil {
+			err = fmt.Errorf(core.ERRORMSG_PROP_PARSE_ERROR, PROPNAME_JWT_EXPIRATION, expiration)
+			return nil, core.SDKErrorf(err, "", "jwt-bad-expiration", common.GetComponentInfo())
+		}
+		authenticator.Expiration = d
+	}
+
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator.
+func (a *CouchDbJwtAuthenticator) AuthenticationType() string {
+	return AUTHTYPE_COUCHDB_JWT
+}
+
+// Validate the authenticator's configuration.
+// Ensures the subject, algorithm and key are valid and not nil.
+func (a *CouchDbJwtAuthenticator) Validate() error {
+	if a.Subject == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Subject"), "no-subject", common.GetComponentInfo())
+	}
+
+	if core.HasBadFirstOrLastChar(a.Subject) {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Subject"), "bad-subject", common.GetComponentInfo())
+	}
+
+	if len(a.Key) == 0 {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Key"), "no-key", common.GetComponentInfo())
+	}
+
+	if a.Expiration < 0 {
+		err := fmt.Errorf("the Expiration property must not be negative")
+		return core.SDKErrorf(err, "", "bad-expiration", common.GetComponentInfo())
+	}
+
+	switch a.Algorithm {
+	case JwtAlgorithmHS256, JwtAlgorithmHS384, JwtAlgorithmHS512:
+		a.rsaKey = nil
+	case JwtAlgorithmRS256, JwtAlgorithmRS384, JwtAlgorithmRS512:
+		key, err := parseRSAPrivateKey(a.Key)
+		if err != nil {
+			return core.SDKErrorf(err, "", "bad-rsa-key", common.GetComponentInfo())
+		}
+		a.rsaKey = key
+	default:
+		err := fmt.Errorf("unsupported JWT signing algorithm %q", a.Algorithm)
+		return core.SDKErrorf(err, "", "bad-algorithm", common.GetComponentInfo())
+	}
+
+	a.validatedKey = bytes.Clone(a.Key)
+	return nil
+}
+
+// Authenticate adds a signed JWT bearer token to a request.
+func (a *CouchDbJwtAuthenticator) Authenticate(request *http.Request) error {
+	token, err := a.getToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// getToken returns the cached token or signs a new one
+// when the cached token is close to its expiration time.
+func (a *CouchDbJwtAuthenticator) getToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// a Key changed since the last validation must not sign with
+	// the stale RSA key nor reuse a token signed with the old Key
+	if a.validatedKey == nil || !bytes.Equal(a.Key, a.validatedKey) {
+		if err := a.Validate(); err != nil {
+			return "", err
+		}
+		a.token = ""
+	}
+
+	now := time.Now()
+	if a.token != "" && now.Before(a.refreshTime) {
+		return a.token, nil
+	}
+
+	expiration := a.Expiration
+	if expiration == 0 {
+		expiration = DefaultJwtExpiration
+	}
+
+	token, err := a.sign(now, now.Add(expiration))
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "jwt-sign-failed", common.GetComponentInfo())
+	}
+
+	// refresh when 80% of the token lifetime has passed
+	a.token = token
+	a.refreshTime = now.Add(expiration * 80 / 100)
+	return a.token, nil
+}
+
+// sign builds and signs a compact serialized JWT.
+func (a *CouchDbJwtAuthenticator) sign(issuedAt, expires time.Time) (string, error) {
+	header := map[string]string{
+		"alg": a.Algorithm,
+		"typ": "JWT",
+	}
+	if a.KeyID != "" {
+		header["kid"] = a.KeyID
+	}
+
+	claims := map[string]interface{}{
+		"sub": a.Subject,
+		"iat": issuedAt.Unix(),
+		"nbf": issuedAt.Unix(),
+		"exp": expires.Unix(),
+	}
+	if len(a.Roles) > 0 {
+		claims["_couchdb.roles"] = a.Roles
+	}
+
+	encodedHeader, err := encodeSegment(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encodedHeader + "." + encodedClaims
+
+	var signature []byte
+	switch a.Algorithm {
+	case JwtAlgorithmHS256, JwtAlgorithmHS384, JwtAlgorithmHS512:
+		mac := hmac.New(jwtHash(a.Algorithm), a.Key)
+		mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	case JwtAlgorithmRS256, JwtAlgorithmRS384, JwtAlgorithmRS512:
+		h := jwtCryptoHash(a.Algorithm)
+		hasher := h.New()
+		hasher.Write([]byte(signingInput))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, a.rsaKey, h, hasher.Sum(nil))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// encodeSegment encodes a JWT header or claims set.
+func encodeSegment(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// jwtCryptoHash maps a JWT algorithm to its hash function.
+func jwtCryptoHash(algorithm string) crypto.Hash {
+	switch algorithm[2:] {
+	case "384":
+		return crypto.SHA384
+	case "512":
+		return crypto.SHA512
+	default:
+		return crypto.SHA256
+	}
+}
+
+// jwtHash returns a hash constructor for HMAC signing.
+func jwtHash(algorithm string) func() hash.Hash {
+	return jwtCryptoHash(algorithm).New
+}
+
+// parseRSAPrivateKey decodes a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("the Key property is not a PEM encoded RSA private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the Key property is not a RSA private key")
+	}
+	return key, nil
+}
+
+// splitList splits a comma separated configuration value,
+// dropping any empty elements.
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,228 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// decodeJwt splits a compact JWT into decoded header and claims and the signature.
+func decodeJwt(token string) (map[string]interface{}, map[string]interface{}, []byte) {
+	parts := strings.Split(token, ".")
+	Expect(parts).To(HaveLen(3))
+
+	header := make(map[string]interface{})
+	b, err := base64.RawURLEncoding.DecodeString(parts[0])
+	Expect(err).To(BeNil())
+	Expect(json.Unmarshal(b, &header)).To(Succeed())
+
+	claims := make(map[string]interface{})
+	b, err = base64.RawURLEncoding.DecodeString(parts[1])
+	Expect(err).To(BeNil())
+	Expect(json.Unmarshal(b, &claims)).To(Succeed())
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	Expect(err).To(BeNil())
+	return header, claims, signature
+}
+
+var _ = Describe("JWT Authenticator Unit Tests", func() {
+	var tempDir string
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "jwt")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	It("Create new JWT Authenticator programmatically", func() {
+		auth, err := NewCouchDbJwtAuthenticator("user", JwtAlgorithmHS256, []byte("secret"))
+		Expect(err).To(BeNil())
+		Expect(auth).ToNot(BeNil())
+		Expect(auth.AuthenticationType()).To(Equal(AUTHTYPE_COUCHDB_JWT))
+
+		auth, err = NewCouchDbJwtAuthenticatorFromMap(map[string]string{
+			"USERNAME":       "user",
+			"JWT_KEY":        "secret",
+			"JWT_ROLES":      "_admin, reader",
+			"JWT_EXPIRATION": "1h",
+		})
+		Expect(err).To(BeNil())
+		Expect(auth.Algorithm).To(Equal(JwtAlgorithmHS256))
+		Expect(auth.Roles).To(Equal([]string{"_admin", "reader"}))
+		Expect(auth.Expiration).To(Equal(time.Hour))
+	})
+
+	It("Test JWT Authenticator instantiation failures", func() {
+		errortests := []struct {
+			subject, algorithm, key string
+		}{
+			{"", JwtAlgorithmHS256, "secret"},
+			{"{invalid-user}", JwtAlgorithmHS256, "secret"},
+			{"user", JwtAlgorithmHS256, ""},
+			{"user", "none", "secret"},
+			{"user", JwtAlgorithmRS256, "not-a-pem-key"},
+		}
+
+		for _, tt := range errortests {
+			_, err := NewCouchDbJwtAuthenticator(tt.subject, tt.algorithm, []byte(tt.key))
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, &expectedErrType)).To(BeTrue())
+		}
+
+		_, err := NewCouchDbJwtAuthenticatorFromMap(map[string]string{
+			"USERNAME":       "user",
+			"JWT_KEY":        "secret",
+			"JWT_EXPIRATION": "soon",
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = NewCouchDbJwtAuthenticatorFromMap(map[string]string{
+			"USERNAME":     "user",
+			"JWT_KEY":      "secret",
+			"JWT_KEY_FILE": "/path/to/key",
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = NewCouchDbJwtAuthenticatorFromMap(nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Signs HMAC tokens with CouchDB claims", func() {
+		auth, err := NewCouchDbJwtAuthenticator("user", JwtAlgorithmHS256, []byte("secret"))
+		Expect(err).To(BeNil())
+		auth.Roles = []string{"_admin"}
+		auth.KeyID = "kid1"
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		authHeader := request.Header.Get("Authorization")
+		Expect(authHeader).To(HavePrefix("Bearer "))
+		token := strings.TrimPrefix(authHeader, "Bearer ")
+
+		header, claims, signature := decodeJwt(token)
+		Expect(header["alg"]).To(Equal("HS256"))
+		Expect(header["kid"]).To(Equal("kid1"))
+		Expect(claims["sub"]).To(Equal("user"))
+		Expect(claims["_couchdb.roles"]).To(Equal([]interface{}{"_admin"}))
+		Expect(claims["exp"]).To(BeNumerically(">", claims["iat"]))
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(token[:strings.LastIndex(token, ".")]))
+		Expect(hmac.Equal(signature, mac.Sum(nil))).To(BeTrue())
+	})
+
+	It("Signs RSA tokens from a key file", func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).To(BeNil())
+		keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+		keyFile := path.Join(tempDir, "jwt.pem")
+		Expect(os.WriteFile(keyFile, keyPem, 0600)).To(Succeed())
+
+		auth, err := NewCouchDbJwtAuthenticatorFromMap(map[string]string{
+			"USERNAME":      "user",
+			"JWT_ALGORITHM": "rs256",
+			"JWT_KEY_FILE":  keyFile,
+		})
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		token := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+		header, _, signature := decodeJwt(token)
+		Expect(header["alg"]).To(Equal("RS256"))
+
+		digest := sha256.Sum256([]byte(token[:strings.LastIndex(token, ".")]))
+		Expect(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature)).To(Succeed())
+	})
+
+	It("Signs with a changed key", func() {
+		verify := func(token string, key *rsa.PrivateKey) error {
+			_, _, signature := decodeJwt(token)
+			digest := sha256.Sum256([]byte(token[:strings.LastIndex(token, ".")]))
+			return rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature)
+		}
+		var keys []*rsa.PrivateKey
+		var keyPems [][]byte
+		for i := 0; i < 2; i++ {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).To(BeNil())
+			keys = append(keys, key)
+			keyPems = append(keyPems, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		}
+
+		auth, err := NewCouchDbJwtAuthenticator("user", JwtAlgorithmRS256, keyPems[0])
+		Expect(err).To(BeNil())
+		token1, err := auth.getToken()
+		Expect(err).To(BeNil())
+		Expect(verify(token1, keys[0])).To(Succeed())
+
+		auth.Key = keyPems[1]
+		token2, err := auth.getToken()
+		Expect(err).To(BeNil())
+		Expect(token2).ToNot(Equal(token1))
+		Expect(verify(token2, keys[1])).To(Succeed())
+
+		auth.Key = []byte("not a key")
+		_, err = auth.getToken()
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Rotates tokens before expiry", func() {
+		auth, err := NewCouchDbJwtAuthenticator("user", JwtAlgorithmHS512, []byte("secret"))
+		Expect(err).To(BeNil())
+
+		token1, err := auth.getToken()
+		Expect(err).To(BeNil())
+		token2, err := auth.getToken()
+		Expect(err).To(BeNil())
+		Expect(token2).To(Equal(token1))
+
+		// move refresh time into the past to force a rotation
+		auth.refreshTime = time.Now().Add(-time.Second)
+		auth.token = "expired"
+		token3, err := auth.getToken()
+		Expect(err).To(BeNil())
+		Expect(token3).ToNot(Equal("expired"))
+		Expect(auth.refreshTime).To(BeTemporally(">", time.Now()))
+	})
+})
@@ -0,0 +1,165 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	AUTHTYPE_COUCHDB_PROXY = "COUCHDB_PROXY"
+)
+
+// Configuration property names used by NewCouchDbProxyAuthenticatorFromMap.
+const (
+	PROPNAME_PROXY_ROLES          = "PROXY_ROLES"
+	PROPNAME_PROXY_SECRET         = "PROXY_SECRET"
+	PROPNAME_PROXY_HASH_ALGORITHM = "PROXY_HASH_ALGORITHM"
+)
+
+// Headers read by CouchDB's proxy authentication handler.
+const (
+	HeaderProxyUserName = "X-Auth-CouchDB-UserName"
+	HeaderProxyRoles    = "X-Auth-CouchDB-Roles"
+	HeaderProxyToken    = "X-Auth-CouchDB-Token"
+)
+
+// CouchDbProxyAuthenticator adds CouchDB proxy authentication headers to
+// requests. When a Secret is set the X-Auth-CouchDB-Token header is added
+// with a hex encoded HMAC of the username, matching CouchDB's
+// proxy_use_secret configuration.
+type CouchDbProxyAuthenticator struct {
+	// [Required] The username to authenticate as.
+	Username string
+
+	// [Optional] The CouchDB roles of the user.
+	Roles []string
+
+	// [Optional] The secret shared with CouchDB's [chttpd_auth] configuration.
+	Secret string
+
+	// [Optional] The HMAC hash algorithm, one of sha, sha224, sha256, sha384
+	// or sha512. Defaults to sha256.
+	HashAlgorithm string
+}
+
+// NewCouchDbProxyAuthenticator constructs a new CouchDbProxyAuthenticator instance.
+func NewCouchDbProxyAuthenticator(username string, roles []string, secret string) (*CouchDbProxyAuthenticator, error) {
+	authenticator := &CouchDbProxyAuthenticator{
+		Username: username,
+		Roles:    roles,
+		Secret:   secret,
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// NewCouchDbProxyAuthenticatorFromMap constructs a new CouchDbProxyAuthenticator instance from a map.
+func NewCouchDbProxyAuthenticatorFromMap(props map[string]string) (*CouchDbProxyAuthenticator, error) {
+	if props == nil {
+		return nil, core.SDKErrorf(nil, core.ERRORMSG_PROPS_MAP_NIL, "missing-props", common.GetComponentInfo())
+	}
+	authenticator := &CouchDbProxyAuthenticator{
+		Username:      props[core.PROPNAME_USERNAME],
+		Roles:         splitList(props[PROPNAME_PROXY_ROLES]),
+		Secret:        props[PROPNAME_PROXY_SECRET],
+		HashAlgorithm: strings.ToLower(props[PROPNAME_PROXY_HASH_ALGORITHM]),
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the authentication type for this authenticator.
+func (a *CouchDbProxyAuthenticator) AuthenticationType() string {
+	return AUTHTYPE_COUCHDB_PROXY
+}
+
+// Validate the authenticator's configuration.
+// Ensures the username is valid and the hash algorithm is supported.
+func (a *CouchDbProxyAuthenticator) Validate() error {
+	if a.Username == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Username"), "no-user", common.GetComponentInfo())
+	}
+
+	if core.HasBadFirstOrLastChar(a.Username) {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Username"), "bad-user", common.GetComponentInfo())
+	}
+
+	if core.HasBadFirstOrLastChar(a.Secret) {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Secret"), "bad-secret", common.GetComponentInfo())
+	}
+
+	if proxyHash(a.HashAlgorithm) == nil {
+		err := fmt.Errorf("unsupported proxy authentication hash algorithm %q", a.HashAlgorithm)
+		return core.SDKErrorf(err, "", "bad-hash-algorithm", common.GetComponentInfo())
+	}
+
+	return nil
+}
+
+// Authenticate adds proxy authentication headers to a request.
+func (a *CouchDbProxyAuthenticator) Authenticate(request *http.Request) error {
+	request.Header.Set(HeaderProxyUserName, a.Username)
+	if len(a.Roles) > 0 {
+		request.Header.Set(HeaderProxyRoles, strings.Join(a.Roles, ","))
+	}
+	if a.Secret != "" {
+		request.Header.Set(HeaderProxyToken, a.token())
+	}
+	return nil
+}
+
+// token returns hex encoded HMAC of the username signed with the secret.
+func (a *CouchDbProxyAuthenticator) token() string {
+	mac := hmac.New(proxyHash(a.HashAlgorithm), []byte(a.Secret))
+	mac.Write([]byte(a.Username))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// proxyHash maps CouchDB's hash algorithm names to hash constructors.
+// It returns nil for unsupported algorithms.
+func proxyHash(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case "", "sha256":
+		return sha256.New
+	case "sha", "sha1":
+		return sha1.New
+	case "sha224":
+		return sha256.New224
+	case "sha384":
+		return sha512.New384
+	case "sha512":
+		return sha512.New
+	default:
+		return nil
+	}
+}
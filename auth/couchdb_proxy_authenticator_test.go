@@ -0,0 +1,106 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" // #nosec G505
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Proxy Authenticator Unit Tests", func() {
+	It("Create new proxy Authenticator programmatically", func() {
+		auth, err := NewCouchDbProxyAuthenticator("user", []string{"reader"}, "secret")
+		Expect(err).To(BeNil())
+		Expect(auth).ToNot(BeNil())
+		Expect(auth.AuthenticationType()).To(Equal(AUTHTYPE_COUCHDB_PROXY))
+
+		auth, err = NewCouchDbProxyAuthenticatorFromMap(map[string]string{
+			"USERNAME":             "user",
+			"PROXY_ROLES":          "reader,writer",
+			"PROXY_SECRET":         "secret",
+			"PROXY_HASH_ALGORITHM": "SHA",
+		})
+		Expect(err).To(BeNil())
+		Expect(auth.Roles).To(Equal([]string{"reader", "writer"}))
+		Expect(auth.HashAlgorithm).To(Equal("sha"))
+	})
+
+	It("Test proxy Authenticator instantiation failures", func() {
+		errortests := []map[string]string{
+			{"USERNAME": ""},
+			{"USERNAME": "{invalid-user}"},
+			{"USERNAME": "user", "PROXY_SECRET": "{invalid-secret}"},
+			{"USERNAME": "user", "PROXY_HASH_ALGORITHM": "md5"},
+		}
+
+		for _, props := range errortests {
+			_, err := NewCouchDbProxyAuthenticatorFromMap(props)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, &expectedErrType)).To(BeTrue())
+		}
+	})
+
+	It("Adds proxy headers with a sha256 token", func() {
+		auth, err := NewCouchDbProxyAuthenticator("user", []string{"reader", "writer"}, "secret")
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte("user"))
+
+		Expect(request.Header.Get(HeaderProxyUserName)).To(Equal("user"))
+		Expect(request.Header.Get(HeaderProxyRoles)).To(Equal("reader,writer"))
+		Expect(request.Header.Get(HeaderProxyToken)).To(Equal(hex.EncodeToString(mac.Sum(nil))))
+	})
+
+	It("Adds proxy headers with a sha1 token", func() {
+		auth := &CouchDbProxyAuthenticator{Username: "user", Secret: "secret", HashAlgorithm: "sha"}
+		Expect(auth.Validate()).To(Succeed())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		mac := hmac.New(sha1.New, []byte("secret"))
+		mac.Write([]byte("user"))
+
+		Expect(request.Header.Get(HeaderProxyToken)).To(Equal(hex.EncodeToString(mac.Sum(nil))))
+		Expect(request.Header.Values(HeaderProxyRoles)).To(BeEmpty())
+	})
+
+	It("Omits the token without a secret", func() {
+		auth, err := NewCouchDbProxyAuthenticator("user", nil, "")
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		Expect(request.Header.Get(HeaderProxyUserName)).To(Equal("user"))
+		Expect(request.Header.Values(HeaderProxyToken)).To(BeEmpty())
+	})
+})
@@ -35,8 +35,15 @@ const (
 // CouchDB authentication cookie, and adds the cookie to requests.
 type CouchDbSessionAuthenticator struct {
 	// [Required] The username and password used to access CouchDB session end-point
+	// unless a CredentialProvider is set.
 	Username, Password string
 
+	// [Optional] A provider consulted for the username and password
+	// each time a new session is requested. When set, it takes precedence
+	// over the Username and Password fields, allowing credentials to be
+	// rotated without rebuilding the client.
+	CredentialProvider CredentialProvider
+
 	// HTTP client used to to obtain CouchDB authentication cookie.
 	client *http.Client
 
@@ -77,11 +84,34 @@ func NewCouchDbSessionAuthenticator(username, password string) (*CouchDbSessionA
 	return authenticator, nil
 }
 
+// NewCouchDbSessionAuthenticatorWithProvider constructs a new CouchDbSessionAuthenticator
+// instance that obtains its credentials from a CredentialProvider.
+func NewCouchDbSessionAuthenticatorWithProvider(provider CredentialProvider) (*CouchDbSessionAuthenticator, error) {
+	authenticator := &CouchDbSessionAuthenticator{
+		CredentialProvider: provider,
+		refresh:            make(chan *session, 1),
+	}
+	if err := authenticator.Validate(); err != nil {
+		return nil, err
+	}
+	client := core.DefaultHTTPClient()
+	authenticator.SetClient(client)
+	return authenticator, nil
+}
+
 // NewCouchDbSessionAuthenticatorFromMap constructs a new NewCouchDbSessionAuthenticator instance from a map.
 func NewCouchDbSessionAuthenticatorFromMap(props map[string]string) (*CouchDbSessionAuthenticator, error) {
 	if props == nil {
 		return nil, core.SDKErrorf(nil, core.ERRORMSG_PROPS_MAP_NIL, "missing-props", common.GetComponentInfo())
 	}
+	if file := props[PROPNAME_CREDENTIALS_FILE]; file != "" {
+		provider := NewFileCredentialProvider(file)
+		// fail on an unreadable file now rather than on the first session
+		if _, err := provider.GetCredentials(context.Background()); err != nil {
+			return nil, err
+		}
+		return NewCouchDbSessionAuthenticatorWithProvider(provider)
+	}
 	username := props[core.PROPNAME_USERNAME]
 	password := props[core.PROPNAME_PASSWORD]
 	return NewCouchDbSessionAuthenticator(username, password)
@@ -93,25 +123,32 @@ func (a *CouchDbSessionAuthenticator) AuthenticationType() string {
 }
 
 // Validate the authenticator's configuration.
-// Ensures the service url, username and password are valid and not nil.
+// Ensures the username and password are valid and not nil,
+// unless they are supplied by a CredentialProvider.
 func (a *CouchDbSessionAuthenticator) Validate() error {
-	if a.Username == "" {
-		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Username"), "no-user", common.GetComponentInfo())
+	if a.CredentialProvider != nil {
+		return nil
 	}
+	return Credentials{a.Username, a.Password}.Validate()
+}
 
-	if a.Password == "" {
-		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Password"), "no-pass", common.GetComponentInfo())
+// credentials returns the username and password for a new session.
+func (a *CouchDbSessionAuthenticator) credentials() (Credentials, error) {
+	if a.CredentialProvider == nil {
+		return Credentials{a.Username, a.Password}, nil
 	}
-
-	if core.HasBadFirstOrLastChar(a.Username) {
-		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Username"), "bad-user", common.GetComponentInfo())
+	ctx := a.ctx
+	if ctx == nil {
+		ctx = context.Background()
 	}
-
-	if core.HasBadFirstOrLastChar(a.Password) {
-		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Password"), "bad-pass", common.GetComponentInfo())
+	credentials, err := a.CredentialProvider.GetCredentials(ctx)
+	if err != nil {
+		return Credentials{}, core.SDKErrorf(err, "", "auth-credentials-fail", common.GetComponentInfo())
 	}
-
-	return nil
+	if err := credentials.Validate(); err != nil {
+		return Credentials{}, err
+	}
+	return credentials, nil
 }
 
 // Authenticate adds session authentication cookie to a request.
@@ -183,6 +220,11 @@ func (a *CouchDbSessionAuthenticator) flushRefreshChannel() {
 
 // requestSession fetches new AuthSession cookie from the server.
 func (a *CouchDbSessionAuthenticator) requestSession() (*session, error) {
+	credentials, err := a.credentials()
+	if err != nil {
+		return nil, err
+	}
+
 	builder, err := core.NewRequestBuilder(core.POST).
 		ResolveRequestURL(a.URL, "/_session", nil)
 	if err != nil {
@@ -190,8 +232,8 @@ func (a *CouchDbSessionAuthenticator) requestSession() (*session, error) {
 	}
 
 	builder.AddHeader(core.CONTENT_TYPE, "application/x-www-form-urlencoded").
-		AddFormData("name", "", "", a.Username).
-		AddFormData("password", "", "", a.Password).
+		AddFormData("name", "", "", credentials.Username).
+		AddFormData("password", "", "", credentials.Password).
 		WithContext(a.ctx)
 
 	// set all the unique headers from original request's client
@@ -206,7 +248,7 @@ func (a *CouchDbSessionAuthenticator) requestSession() (*session, error) {
 		return nil, err
 	}
 
-	req.SetBasicAuth(a.Username, a.Password)
+	req.SetBasicAuth(credentials.Username, credentials.Password)
 
 	resp, err := a.client.Do(req)
 	if err != nil {
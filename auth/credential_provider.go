@@ -0,0 +1,197 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PROPNAME_CREDENTIALS_FILE is the service property naming a file
+// read by FileCredentialProvider for authenticators created from external configuration.
+const PROPNAME_CREDENTIALS_FILE = "CREDENTIALS_FILE"
+
+// Credentials is a username and password pair,
+// for example Cloudant legacy credentials or a legacy API key.
+type Credentials struct {
+	Username, Password string
+}
+
+// Validate ensures the username and password are valid and not empty.
+func (c Credentials) Validate() error {
+	if c.Username == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Username"), "no-user", common.GetComponentInfo())
+	}
+
+	if c.Password == "" {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Password"), "no-pass", common.GetComponentInfo())
+	}
+
+	if core.HasBadFirstOrLastChar(c.Username) {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Username"), "bad-user", common.GetComponentInfo())
+	}
+
+	if core.HasBadFirstOrLastChar(c.Password) {
+		return core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_INVALID, "Password"), "bad-pass", common.GetComponentInfo())
+	}
+
+	return nil
+}
+
+// CredentialProvider supplies credentials to authenticators.
+// Authenticators call GetCredentials whenever they need to
+// authenticate again, so implementations may return rotated credentials
+// without the client being rebuilt.
+// Implementations must be safe for concurrent use.
+type CredentialProvider interface {
+	GetCredentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialProviderFunc is an adapter to allow the use of
+// an ordinary function as a CredentialProvider.
+type CredentialProviderFunc func(ctx context.Context) (Credentials, error)
+
+// GetCredentials calls f(ctx).
+func (f CredentialProviderFunc) GetCredentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentialProvider always returns the same credentials.
+type StaticCredentialProvider struct {
+	credentials Credentials
+}
+
+// NewStaticCredentialProvider constructs a new StaticCredentialProvider instance.
+func NewStaticCredentialProvider(username, password string) *StaticCredentialProvider {
+	return &StaticCredentialProvider{Credentials{username, password}}
+}
+
+// GetCredentials returns the static credentials.
+func (p *StaticCredentialProvider) GetCredentials(context.Context) (Credentials, error) {
+	return p.credentials, nil
+}
+
+// EnvironmentCredentialProvider reads the USERNAME and PASSWORD properties
+// of a service from external configuration sources, that is
+// the credentials file, environment variables and VCAP_SERVICES,
+// each time the credentials are requested.
+type EnvironmentCredentialProvider struct {
+	// The service name prefix of the configuration properties, for example "CLOUDANT".
+	CredentialKey string
+}
+
+// NewEnvironmentCredentialProvider constructs a new EnvironmentCredentialProvider instance.
+func NewEnvironmentCredentialProvider(credentialKey string) *EnvironmentCredentialProvider {
+	return &EnvironmentCredentialProvider{CredentialKey: credentialKey}
+}
+
+// GetCredentials returns the credentials from the external configuration.
+func (p *EnvironmentCredentialProvider) GetCredentials(context.Context) (Credentials, error) {
+	props, err := core.GetServiceProperties(p.CredentialKey)
+	if err != nil {
+		return Credentials{}, err
+	}
+	credentials := Credentials{
+		Username: props[core.PROPNAME_USERNAME],
+		Password: props[core.PROPNAME_PASSWORD],
+	}
+	if err := credentials.Validate(); err != nil {
+		return Credentials{}, err
+	}
+	return credentials, nil
+}
+
+// FileCredentialProvider reads credentials from a file of KEY=VALUE lines
+// with USERNAME and PASSWORD keys, for example a mounted secret.
+// The file is read again whenever its modification time or size changes.
+type FileCredentialProvider struct {
+	// The path of the credentials file.
+	Path string
+
+	credentials Credentials
+	modTime     time.Time
+	size        int64
+	mu          sync.Mutex
+}
+
+// NewFileCredentialProvider constructs a new FileCredentialProvider instance.
+func NewFileCredentialProvider(path string) *FileCredentialProvider {
+	return &FileCredentialProvider{Path: path}
+}
+
+// GetCredentials returns the credentials from the file.
+func (p *FileCredentialProvider) GetCredentials(context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return Credentials{}, core.SDKErrorf(err, "", "credentials-file-stat", common.GetComponentInfo())
+	}
+	if !p.modTime.IsZero() && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.credentials, nil
+	}
+
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return Credentials{}, core.SDKErrorf(err, "", "credentials-file-read", common.GetComponentInfo())
+	}
+	credentials := parseCredentials(data)
+	if err := credentials.Validate(); err != nil {
+		return Credentials{}, err
+	}
+
+	p.credentials = credentials
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	return p.credentials, nil
+}
+
+// parseCredentials reads USERNAME and PASSWORD from KEY=VALUE lines.
+// Keys may carry a service name prefix, for example CLOUDANT_USERNAME.
+func parseCredentials(data []byte) Credentials {
+	var credentials Credentials
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch {
+		case key == core.PROPNAME_USERNAME || strings.HasSuffix(key, "_"+core.PROPNAME_USERNAME):
+			credentials.Username = value
+		case key == core.PROPNAME_PASSWORD || strings.HasSuffix(key, "_"+core.PROPNAME_PASSWORD):
+			credentials.Password = value
+		}
+	}
+	return credentials
+}
@@ -0,0 +1,181 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Credential Provider Unit Tests", func() {
+	ctx := context.Background()
+	var tempDir string
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "credentials")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	It("Returns static credentials", func() {
+		provider := NewStaticCredentialProvider("user", "pass")
+		credentials, err := provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(Credentials{"user", "pass"}))
+	})
+
+	It("Returns credentials from a function", func() {
+		provider := CredentialProviderFunc(func(context.Context) (Credentials, error) {
+			return Credentials{"user", "pass"}, nil
+		})
+		credentials, err := provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials.Username).To(Equal("user"))
+	})
+
+	It("Re-reads credentials from the environment", func() {
+		Expect(os.Setenv("ROTATING_USERNAME", "user1")).To(Succeed())
+		Expect(os.Setenv("ROTATING_PASSWORD", "pass1")).To(Succeed())
+		defer func() {
+			Expect(os.Unsetenv("ROTATING_USERNAME")).To(Succeed())
+			Expect(os.Unsetenv("ROTATING_PASSWORD")).To(Succeed())
+		}()
+
+		provider := NewEnvironmentCredentialProvider("rotating")
+		credentials, err := provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(Credentials{"user1", "pass1"}))
+
+		Expect(os.Setenv("ROTATING_PASSWORD", "pass2")).To(Succeed())
+		credentials, err = provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(Credentials{"user1", "pass2"}))
+	})
+
+	It("Fails on missing environment credentials", func() {
+		provider := NewEnvironmentCredentialProvider("missing")
+		_, err := provider.GetCredentials(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.As(err, &expectedErrType)).To(BeTrue())
+	})
+
+	It("Re-reads credentials when the file changes", func() {
+		file := path.Join(tempDir, "credentials")
+		Expect(os.WriteFile(file, []byte("# rotated daily\nCLOUDANT_USERNAME=user1\nCLOUDANT_PASSWORD=pass1\n"), 0600)).To(Succeed())
+
+		provider := NewFileCredentialProvider(file)
+		credentials, err := provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(Credentials{"user1", "pass1"}))
+
+		Expect(os.WriteFile(file, []byte("USERNAME=user2\nPASSWORD=password2\n"), 0600)).To(Succeed())
+		future := time.Now().Add(time.Minute)
+		Expect(os.Chtimes(file, future, future)).To(Succeed())
+
+		credentials, err = provider.GetCredentials(ctx)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(Credentials{"user2", "password2"}))
+
+		Expect(os.WriteFile(file, []byte("USERNAME=user3\n"), 0600)).To(Succeed())
+		_, err = provider.GetCredentials(ctx)
+		Expect(err).To(HaveOccurred())
+
+		_, err = NewFileCredentialProvider(path.Join(tempDir, "missing")).GetCredentials(ctx)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Uses rotated credentials for a new session", func() {
+		var names []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			names = append(names, r.PostForm.Get("name"))
+			http.SetCookie(w, &http.Cookie{
+				Name:    "AuthSession",
+				Value:   fmt.Sprintf("fake-%d", len(names)),
+				Expires: time.Now().Add(time.Hour),
+			})
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		username := "user1"
+		provider := CredentialProviderFunc(func(context.Context) (Credentials, error) {
+			return Credentials{username, "pass"}, nil
+		})
+		auth, err := NewCouchDbSessionAuthenticatorWithProvider(provider)
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, server.URL+"/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		username = "user2"
+		auth.session = nil
+		Expect(auth.Authenticate(request)).To(Succeed())
+
+		Expect(names).To(Equal([]string{"user1", "user2"}))
+	})
+
+	It("Fails the session request on provider errors", func() {
+		provider := CredentialProviderFunc(func(context.Context) (Credentials, error) {
+			return Credentials{}, errors.New("vault unavailable")
+		})
+		auth, err := NewCouchDbSessionAuthenticatorWithProvider(provider)
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		err = auth.Authenticate(request)
+		Expect(err).To(MatchError(ContainSubstring("vault unavailable")))
+	})
+
+	It("Adds rotated basic credentials to each request", func() {
+		password := "pass1"
+		provider := CredentialProviderFunc(func(context.Context) (Credentials, error) {
+			return Credentials{"user", password}, nil
+		})
+		auth, err := NewBasicAuthenticatorWithProvider(provider)
+		Expect(err).To(BeNil())
+
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:5984/db", nil)
+		Expect(err).To(BeNil())
+		Expect(auth.Authenticate(request)).To(Succeed())
+		_, p, _ := request.BasicAuth()
+		Expect(p).To(Equal("pass1"))
+
+		password = "pass2"
+		Expect(auth.Authenticate(request)).To(Succeed())
+		_, p, _ = request.BasicAuth()
+		Expect(p).To(Equal("pass2"))
+
+		_, err = NewBasicAuthenticatorWithProvider(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -17,6 +17,7 @@
 package base
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -35,6 +36,8 @@ import (
 
 type BaseService struct {
 	serviceUrlPathSegmentsSize int
+	plusUnencoded              bool
+	decodingIssueHandler       func(DecodingIssue)
 	*core.BaseService
 }
 
@@ -108,7 +111,7 @@ func NewBaseService(opts *core.ServiceOptions) (*BaseService, error) {
 	// Set a default value for the User-Agent http header.
 	baseService.SetUserAgent(buildUserAgent())
 
-	service := &BaseService{0, baseService}
+	service := &BaseService{0, false, nil, baseService}
 	// Set a default HTTP client
 	client := core.DefaultHTTPClient()
 	client.Timeout = 6 * time.Minute
@@ -119,7 +122,20 @@ func NewBaseService(opts *core.ServiceOptions) (*BaseService, error) {
 
 func (c *BaseService) Clone() *BaseService {
 	baseService := c.BaseService.Clone()
-	return &BaseService{c.serviceUrlPathSegmentsSize, baseService}
+	return &BaseService{c.serviceUrlPathSegmentsSize, c.plusUnencoded, c.decodingIssueHandler, baseService}
+}
+
+// SetEncodePlus sets whether the + character in request path elements,
+// for example in document IDs and attachment names, is percent-encoded.
+// Cloudant and some CouchDB versions decode an unencoded + in the path to a space,
+// so it is encoded by default. Disable the encoding to send the path unchanged.
+func (c *BaseService) SetEncodePlus(encode bool) {
+	c.plusUnencoded = !encode
+}
+
+// GetEncodePlus returns whether the + character in request path elements is percent-encoded.
+func (c *BaseService) GetEncodePlus() bool {
+	return !c.plusUnencoded
 }
 
 func (c *BaseService) Request(req *http.Request, result interface{}) (detailedResponse *core.DetailedResponse, err error) {
@@ -160,7 +176,20 @@ func (c *BaseService) Request(req *http.Request, result interface{}) (detailedRe
 			}
 		}
 	}
-	return c.BaseService.Request(req, result)
+	if err := addQueryParams(operationId, req); err != nil {
+		return nil, err
+	}
+	if !c.plusUnencoded && strings.Contains(req.URL.Path, "+") {
+		// URL path escaping leaves + unencoded, set the raw path with
+		// %2B that the server decodes back to +
+		req.URL.RawPath = strings.ReplaceAll(req.URL.EscapedPath(), "+", "%2B")
+	}
+	detailedResponse, err = c.BaseService.Request(req, result)
+	if err == nil {
+		keepRawResult(req.Context(), result)
+		c.prepareResponse(operationId, result)
+	}
+	return
 }
 
 func (c *BaseService) SetServiceURL(url string) error {
@@ -231,8 +260,27 @@ func GetAuthenticatorFromEnvironment(credentialKey string) (core.Authenticator,
 		authType, ok = props["AUTHTYPE"]
 	}
 
+	if ok && strings.EqualFold(authType, auth.AUTHTYPE_COUCHDB_JWT) {
+		authenticator, err := auth.NewCouchDbJwtAuthenticatorFromMap(props)
+		if err != nil {
+			return nil, err
+		}
+		return authenticator, nil
+	}
+
+	if ok && strings.EqualFold(authType, auth.AUTHTYPE_COUCHDB_PROXY) {
+		authenticator, err := auth.NewCouchDbProxyAuthenticatorFromMap(props)
+		if err != nil {
+			return nil, err
+		}
+		return authenticator, nil
+	}
+
 	if ok && strings.EqualFold(authType, auth.AUTHTYPE_COUCHDB_SESSION) {
 		authenticator, err := auth.NewCouchDbSessionAuthenticatorFromMap(props)
+		if err != nil {
+			return nil, err
+		}
 		if url, ok := props[core.PROPNAME_SVC_URL]; ok && url != "" {
 			authenticator.URL = url
 		}
@@ -242,7 +290,21 @@ func GetAuthenticatorFromEnvironment(credentialKey string) (core.Authenticator,
 				authenticator.DisableSSLVerification = true
 			}
 		}
-		return authenticator, err
+		return authenticator, nil
+	}
+
+	// rotating basic credentials are opt-in, otherwise core's authenticator is used
+	if file := props[auth.PROPNAME_CREDENTIALS_FILE]; ok && file != "" && strings.EqualFold(authType, core.AUTHTYPE_BASIC) {
+		provider := auth.NewFileCredentialProvider(file)
+		// fail on an unreadable file now rather than on the first request
+		if _, err := provider.GetCredentials(context.Background()); err != nil {
+			return nil, err
+		}
+		authenticator, err := auth.NewBasicAuthenticatorWithProvider(provider)
+		if err != nil {
+			return nil, err
+		}
+		return authenticator, nil
 	}
 
 	return core.GetAuthenticatorFromEnvironment(credentialKey)
@@ -272,6 +334,12 @@ func UnmarshalPrimitiveSpecial(rawInput map[string]json.RawMessage, propertyName
 		return
 	}
 
+	// Older servers return empty arrays instead of empty objects
+	// for the counts of results without matches:
+	if raw, ok := rawInput[propertyName]; ok {
+		rawInput = map[string]json.RawMessage{propertyName: emptyArraysAsObjects(raw)}
+	}
+
 	// Unmarshal rawInput with interim map[string]map[string]float64 type:
 	var converted map[string]map[string]float64
 	err = core.UnmarshalPrimitive(rawInput, propertyName, &converted)
@@ -290,3 +358,32 @@ func UnmarshalPrimitiveSpecial(rawInput map[string]json.RawMessage, propertyName
 	err = json.Unmarshal(rawMsg, &result)
 	return
 }
+
+// emptyArraysAsObjects returns the raw facets with an empty array
+// of the facets or of the values of a facet replaced by an empty object.
+func emptyArraysAsObjects(raw json.RawMessage) json.RawMessage {
+	isEmptyArray := func(r json.RawMessage) bool {
+		var a []json.RawMessage
+		return json.Unmarshal(r, &a) == nil && a != nil && len(a) == 0
+	}
+	if !strings.Contains(string(raw), "[") {
+		return raw
+	}
+	if isEmptyArray(raw) {
+		return json.RawMessage("{}")
+	}
+	var facets map[string]json.RawMessage
+	if json.Unmarshal(raw, &facets) != nil {
+		return raw
+	}
+	for name, values := range facets {
+		if isEmptyArray(values) {
+			facets[name] = json.RawMessage("{}")
+		}
+	}
+	b, err := json.Marshal(facets)
+	if err != nil {
+		return raw
+	}
+	return b
+}
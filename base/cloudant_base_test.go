@@ -17,6 +17,7 @@
 package base
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -183,6 +184,53 @@ var _ = Describe(`Cloudant custom base service UT`, func() {
 		Expect(errors.As(err, &expectedErrType)).To(BeTrue())
 	})
 
+	It("Encodes + in document IDs and attachment names", func() {
+		var requestURI string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestURI = r.RequestURI
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte("{}"))
+		}))
+		defer server.Close()
+
+		cloudant, err := NewBaseService(&core.ServiceOptions{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(cloudant.GetEncodePlus()).To(BeTrue())
+
+		request := func() {
+			pathParamsMap := map[string]string{
+				"db":              "testDatabase",
+				"doc_id":          "test+Document",
+				"attachment_name": "a+b c.txt",
+			}
+			builder := core.NewRequestBuilder(core.GET)
+			_, err := builder.ResolveRequestURL(cloudant.Options.URL, `/{db}/{doc_id}/{attachment_name}`, pathParamsMap)
+			Expect(err).To(BeNil())
+			for headerName, headerValue := range common.GetSdkHeaders("cloudant", "V1", "GetAttachment") {
+				builder.AddHeader(headerName, headerValue)
+			}
+			req, err := builder.Build()
+			Expect(err).To(BeNil())
+			_, err = cloudant.Request(req, nil)
+			Expect(err).To(BeNil())
+		}
+
+		request()
+		Expect(requestURI).To(Equal("/testDatabase/test%2BDocument/a%2Bb%20c.txt"))
+
+		clone := cloudant.Clone()
+		Expect(clone.GetEncodePlus()).To(BeTrue())
+
+		cloudant.SetEncodePlus(false)
+		Expect(cloudant.GetEncodePlus()).To(BeFalse())
+		request()
+		Expect(requestURI).To(Equal("/testDatabase/test+Document/a+b%20c.txt"))
+	})
+
 	It("Validates URL trailing slash is stripped", func() {
 		cloudant, err := NewBaseService(&core.ServiceOptions{
 			URL:           "https://cloudant.example/",
@@ -232,6 +280,21 @@ var _ = Describe(`Cloudant custom base service UT`, func() {
 		Expect(ranges).To(Equal(expectedRanges))
 	})
 
+	It("Unmarshals SearchResult Counts without matches", func() {
+		searchResult := map[string]json.RawMessage{
+			"counts": json.RawMessage(`{"name": []}`),
+			"ranges": json.RawMessage(`[]`),
+		}
+		var counts map[string]map[string]int64
+		err := UnmarshalPrimitiveSpecial(searchResult, "counts", &counts, "*cloudantv1.SearchResult")
+		Expect(err).To(BeNil())
+		Expect(counts).To(Equal(map[string]map[string]int64{"name": {}}))
+		var ranges map[string]map[string]int64
+		err = UnmarshalPrimitiveSpecial(searchResult, "ranges", &ranges, "*cloudantv1.SearchResult")
+		Expect(err).To(BeNil())
+		Expect(ranges).To(BeEmpty())
+	})
+
 	It("Cannot unmarshal Counts and Ranges wrong type", func() {
 		searchResult := map[string]json.RawMessage{
 			"counts": json.RawMessage(`{"name": {"Alan": 1743.6}}`),
@@ -324,6 +387,93 @@ var _ = Describe(`Cloudant custom base service UT`, func() {
 			Expect(authenticator.AuthenticationType()).To(Equal(core.AUTHTYPE_IAM))
 		})
 
+		It("Create Authenticators with rotating credentials from environment", func() {
+			authenticator, err := GetAuthenticatorFromEnvironment("service5")
+			Expect(err).To(BeNil())
+			_, ok := authenticator.(*core.BasicAuthenticator)
+			Expect(ok).To(BeTrue())
+
+			tempDir, err := os.MkdirTemp("", "credentials")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(tempDir)
+			file := path.Join(tempDir, "credentials")
+			Expect(os.WriteFile(file, []byte("USERNAME=file-username\nPASSWORD=file-password\n"), 0600)).To(Succeed())
+			for key, value := range map[string]string{
+				"SERVICE8_AUTH_TYPE":        "COUCHDB_SESSION",
+				"SERVICE8_URL":              "https://my-couch-8",
+				"SERVICE8_CREDENTIALS_FILE": file,
+				"SERVICE9_AUTH_TYPE":        "BASIC",
+				"SERVICE9_URL":              "https://my-couch-9",
+				"SERVICE9_CREDENTIALS_FILE": file,
+			} {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				defer os.Unsetenv(key)
+			}
+
+			authenticator, err = GetAuthenticatorFromEnvironment("service8")
+			Expect(err).To(BeNil())
+			sessionAuth, ok := authenticator.(*auth.CouchDbSessionAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(sessionAuth.URL).To(Equal("https://my-couch-8"))
+			credentials, err := sessionAuth.CredentialProvider.GetCredentials(context.Background())
+			Expect(err).To(BeNil())
+			Expect(credentials).To(Equal(auth.Credentials{Username: "file-username", Password: "file-password"}))
+
+			authenticator, err = GetAuthenticatorFromEnvironment("service9")
+			Expect(err).To(BeNil())
+			basicAuth, ok := authenticator.(*auth.BasicAuthenticator)
+			Expect(ok).To(BeTrue())
+			request, err := http.NewRequest(http.MethodGet, "https://my-couch-9/db", nil)
+			Expect(err).To(BeNil())
+			Expect(basicAuth.Authenticate(request)).To(Succeed())
+			username, password, ok := request.BasicAuth()
+			Expect(ok).To(BeTrue())
+			Expect(username).To(Equal("file-username"))
+			Expect(password).To(Equal("file-password"))
+		})
+
+		It("Fails to create Authenticators with an unreadable credentials file", func() {
+			for key, value := range map[string]string{
+				"SERVICE10_AUTH_TYPE":        "COUCHDB_SESSION",
+				"SERVICE10_CREDENTIALS_FILE": "/missing/credentials",
+				"SERVICE11_AUTH_TYPE":        "BASIC",
+				"SERVICE11_CREDENTIALS_FILE": "/missing/credentials",
+			} {
+				Expect(os.Setenv(key, value)).To(Succeed())
+				defer os.Unsetenv(key)
+			}
+
+			for _, credentialKey := range []string{"service10", "service11"} {
+				authenticator, err := GetAuthenticatorFromEnvironment(credentialKey)
+				Expect(err).ToNot(BeNil())
+				Expect(authenticator).To(BeNil())
+			}
+		})
+
+		It("Create new CouchDB JWT and proxy Authenticators from environment", func() {
+			authenticator, err := GetAuthenticatorFromEnvironment("service6")
+			Expect(err).To(BeNil())
+			Expect(authenticator).ToNot(BeNil())
+			Expect(authenticator.AuthenticationType()).To(Equal(auth.AUTHTYPE_COUCHDB_JWT))
+
+			jwtAuth, ok := authenticator.(*auth.CouchDbJwtAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(jwtAuth.Subject).To(Equal("my-username"))
+			Expect(jwtAuth.Algorithm).To(Equal(auth.JwtAlgorithmHS256))
+			Expect(jwtAuth.Roles).To(Equal([]string{"_admin", "reader"}))
+
+			authenticator, err = GetAuthenticatorFromEnvironment("service7")
+			Expect(err).To(BeNil())
+			Expect(authenticator).ToNot(BeNil())
+			Expect(authenticator.AuthenticationType()).To(Equal(auth.AUTHTYPE_COUCHDB_PROXY))
+
+			proxyAuth, ok := authenticator.(*auth.CouchDbProxyAuthenticator)
+			Expect(ok).To(BeTrue())
+			Expect(proxyAuth.Username).To(Equal("my-username"))
+			Expect(proxyAuth.Roles).To(Equal([]string{"reader", "writer"}))
+			Expect(proxyAuth.Secret).To(Equal("my-proxy-secret"))
+		})
+
 		It("Validates cookie jar enabled for all auths", func() {
 			couchDbAuth, err := GetAuthenticatorFromEnvironment("service1")
 			Expect(err).To(BeNil())
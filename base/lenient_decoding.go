@@ -0,0 +1,271 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DecodingIssue is a property of a response skipped by lenient decoding.
+type DecodingIssue struct {
+	// The path of the property in the response, for example rows[2].doc.
+	Path string
+
+	// The error of a property that cannot be decoded into the model,
+	// nil for a property unknown to the model.
+	Err error
+}
+
+// EnableLenientDecoding makes the service decode responses leniently.
+// Unknown properties and properties that cannot be decoded into the response model
+// are reported to the handler and skipped instead of failing the whole response.
+func (c *BaseService) EnableLenientDecoding(handler func(DecodingIssue)) {
+	if handler == nil {
+		handler = func(DecodingIssue) {}
+	}
+	c.decodingIssueHandler = handler
+}
+
+// DisableLenientDecoding makes the service fail on responses that cannot be decoded, the default.
+func (c *BaseService) DisableLenientDecoding() {
+	c.decodingIssueHandler = nil
+}
+
+// IsLenientDecodingEnabled returns whether the service decodes responses leniently.
+func (c *BaseService) IsLenientDecodingEnabled() bool {
+	return c.decodingIssueHandler != nil
+}
+
+// responseModels are the types of the JSON responses by operation ID.
+var responseModels = make(map[string]reflect.Type)
+
+// legacyFormOperationIds are the operations with responses that may contain models in a legacy form,
+// for example the string form "keyword" of a search analyzer instead of {"name": "keyword"}.
+var legacyFormOperationIds = []string{
+	"GetDesignDocument",
+	"GetIndexesInformation",
+	"PostExplain",
+	"PostPartitionExplain",
+}
+
+// RegisterResponseModels registers the models of the JSON responses of operations by operation ID
+// for lenient decoding and for decoding the legacy forms of models.
+// A model is a nil pointer to a model, or a nil slice or map of models.
+func RegisterResponseModels(models map[string]interface{}) {
+	for operationId, model := range models {
+		responseModels[operationId] = reflect.TypeOf(model)
+	}
+}
+
+// prepareResponse prepares the raw JSON response of an operation for decoding into its model,
+// with the legacy forms of models in their current form and, when lenient decoding is enabled,
+// without the properties that cannot be decoded.
+func (c *BaseService) prepareResponse(operationId string, result interface{}) {
+	t, ok := responseModels[operationId]
+	if !ok || (c.decodingIssueHandler == nil && !slices.Contains(legacyFormOperationIds, operationId)) {
+		return
+	}
+	d := responseDecoder{handler: c.decodingIssueHandler}
+	switch r := result.(type) {
+	case *map[string]json.RawMessage:
+		if *r != nil {
+			*r, _ = d.object("", *r, t)
+		}
+	case *[]json.RawMessage:
+		if *r != nil && t.Kind() == reflect.Slice {
+			*r, _ = d.array("", *r, t.Elem())
+		}
+	}
+}
+
+// responseDecoder checks the raw values of a response against the types of the models
+// in a single pass, with a nil handler for the legacy forms only.
+type responseDecoder struct {
+	handler func(DecodingIssue)
+}
+
+var jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// value returns the raw value prepared for decoding into the type,
+// whether to keep it and whether it changed.
+func (d responseDecoder) value(path string, raw json.RawMessage, t reflect.Type) (json.RawMessage, bool, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	trimmed := bytes.TrimSpace(raw)
+	if t.Kind() == reflect.Interface || bytes.Equal(trimmed, []byte("null")) {
+		return raw, true, false
+	}
+	if !reflect.PointerTo(t).Implements(jsonUnmarshalerType) && len(trimmed) > 0 {
+		changed := false
+		switch {
+		case t.Kind() == reflect.Struct && trimmed[0] == '"':
+			// the string form of a model with only a name
+			var name string
+			if _, ok := modelFieldsOf(t).fields["name"]; ok && json.Unmarshal(raw, &name) == nil {
+				trimmed, _ = json.Marshal(map[string]string{"name": name})
+				changed = true
+			}
+		case t.Kind() == reflect.Map && bytes.Equal(trimmed, []byte("[]")):
+			// an empty array of older servers instead of an empty object
+			trimmed = json.RawMessage("{}")
+			changed = true
+		}
+		switch {
+		case (t.Kind() == reflect.Struct || t.Kind() == reflect.Map) && trimmed[0] == '{':
+			var members map[string]json.RawMessage
+			if err := json.Unmarshal(trimmed, &members); err != nil {
+				return d.skip(path, raw, err)
+			}
+			members, membersChanged := d.object(path, members, t)
+			if !membersChanged && !changed {
+				return raw, true, false
+			}
+			b, _ := json.Marshal(members)
+			return b, true, true
+		case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 && trimmed[0] == '[':
+			var elements []json.RawMessage
+			if err := json.Unmarshal(trimmed, &elements); err != nil {
+				return d.skip(path, raw, err)
+			}
+			elements, elementsChanged := d.array(path, elements, t.Elem())
+			if !elementsChanged && !changed {
+				return raw, true, false
+			}
+			b, _ := json.Marshal(elements)
+			return b, true, true
+		}
+		raw = trimmed
+	}
+	if d.handler == nil {
+		// the decoding of the model reports the errors
+		return raw, true, false
+	}
+	if err := json.Unmarshal(raw, reflect.New(t).Interface()); err != nil {
+		return d.skip(path, raw, err)
+	}
+	return raw, true, false
+}
+
+// object prepares the members of an object for decoding into a model or map type
+// and returns whether they changed.
+func (d responseDecoder) object(path string, members map[string]json.RawMessage, t reflect.Type) (map[string]json.RawMessage, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var model modelFields
+	switch t.Kind() {
+	case reflect.Struct:
+		model = modelFieldsOf(t)
+	case reflect.Map:
+		model.additional = t.Elem()
+	default:
+		return members, false
+	}
+	changed := false
+	for _, name := range slices.Sorted(maps.Keys(members)) {
+		memberPath := propertyPath(path, name)
+		memberType, ok := model.fields[name]
+		if !ok {
+			memberType = model.additional
+		}
+		if memberType == nil {
+			// a property unknown to the model is not decoded
+			if d.handler != nil {
+				d.handler(DecodingIssue{Path: memberPath})
+			}
+			continue
+		}
+		member, keep, memberChanged := d.value(memberPath, members[name], memberType)
+		if !keep {
+			delete(members, name)
+		} else if memberChanged {
+			members[name] = member
+		}
+		changed = changed || memberChanged
+	}
+	return members, changed
+}
+
+// array prepares the elements of an array for decoding into the element type
+// and returns whether they changed.
+func (d responseDecoder) array(path string, elements []json.RawMessage, t reflect.Type) ([]json.RawMessage, bool) {
+	kept := make([]json.RawMessage, 0, len(elements))
+	changed := false
+	for i, element := range elements {
+		element, keep, elementChanged := d.value(path+"["+strconv.Itoa(i)+"]", element, t)
+		if keep {
+			kept = append(kept, element)
+		}
+		changed = changed || elementChanged
+	}
+	return kept, changed
+}
+
+// skip reports a value that cannot be decoded and drops it,
+// or keeps it for the decoding of the model to fail without a handler.
+func (d responseDecoder) skip(path string, raw json.RawMessage, err error) (json.RawMessage, bool, bool) {
+	if d.handler == nil {
+		return raw, true, false
+	}
+	d.handler(DecodingIssue{Path: path, Err: err})
+	return nil, false, true
+}
+
+// modelFields are the types of the properties of a model,
+// with the type of the additional properties of models that have them.
+type modelFields struct {
+	fields     map[string]reflect.Type
+	additional reflect.Type
+}
+
+var modelFieldsCache sync.Map
+
+// modelFieldsOf returns the fields of a model struct type.
+func modelFieldsOf(t reflect.Type) modelFields {
+	if cached, ok := modelFieldsCache.Load(t); ok {
+		return cached.(modelFields)
+	}
+	model := modelFields{fields: make(map[string]reflect.Type)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "additionalProperties" && field.Type.Kind() == reflect.Map {
+			model.additional = field.Type.Elem()
+			continue
+		}
+		if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+			model.fields[name] = field.Type
+		}
+	}
+	modelFieldsCache.Store(t, model)
+	return model
+}
+
+// propertyPath returns the path of the named property of the value at the path.
+func propertyPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,174 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type testItem struct {
+	ID   *string `json:"id,omitempty"`
+	Size *int64  `json:"size,omitempty"`
+}
+
+type testNamed struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type testModel struct {
+	Name     *string              `json:"name,omitempty"`
+	Items    []testItem           `json:"items,omitempty"`
+	Named    map[string]testNamed `json:"named,omitempty"`
+	Analyzer *testNamed           `json:"analyzer,omitempty"`
+	Counts   map[string]int64     `json:"counts,omitempty"`
+	Document *testDocument        `json:"doc,omitempty"`
+}
+
+type testDocument struct {
+	Rev *string `json:"_rev,omitempty"`
+
+	additionalProperties map[string]*string
+}
+
+func init() {
+	RegisterResponseModels(map[string]interface{}{
+		"TestModel":         (*testModel)(nil),
+		"TestItems":         []testItem(nil),
+		"GetDesignDocument": (*testModel)(nil),
+	})
+}
+
+func rawObject(s string) map[string]json.RawMessage {
+	m := make(map[string]json.RawMessage)
+	Expect(json.Unmarshal([]byte(s), &m)).To(Succeed())
+	return m
+}
+
+func decodeTestModel(m map[string]json.RawMessage) (*testModel, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var result *testModel
+	err = json.Unmarshal(b, &result)
+	return result, err
+}
+
+var _ = Describe(`Lenient decoding UT`, func() {
+	var (
+		cloudant *BaseService
+		issues   []DecodingIssue
+	)
+
+	BeforeEach(func() {
+		var err error
+		cloudant, err = NewBaseService(&core.ServiceOptions{
+			URL:           "https://cloudant.example",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		issues = nil
+	})
+
+	It("Keeps malformed properties by default", func() {
+		Expect(cloudant.IsLenientDecodingEnabled()).To(BeFalse())
+		raw := rawObject(`{"name":1}`)
+		cloudant.prepareResponse("TestModel", &raw)
+		_, err := decodeTestModel(raw)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Skips and reports malformed and unknown properties", func() {
+		cloudant.EnableLenientDecoding(func(issue DecodingIssue) {
+			issues = append(issues, issue)
+		})
+		Expect(cloudant.IsLenientDecodingEnabled()).To(BeTrue())
+		Expect(cloudant.Clone().IsLenientDecodingEnabled()).To(BeTrue())
+
+		raw := rawObject(`{
+			"name": ["a"],
+			"items": [{"id": "a", "size": 1}, {"id": "b", "size": "big"}, 3, {"id": "c", "extra": true}],
+			"doc": {"_rev": "1-a", "color": "red", "size": 1},
+			"other": 1
+		}`)
+		cloudant.prepareResponse("TestModel", &raw)
+		result, err := decodeTestModel(raw)
+		Expect(err).To(BeNil())
+		Expect(result.Name).To(BeNil())
+		Expect(result.Items).To(HaveLen(3))
+		Expect(*result.Items[1].ID).To(Equal("b"))
+		Expect(result.Items[1].Size).To(BeNil())
+		Expect(*result.Items[2].ID).To(Equal("c"))
+		Expect(*result.Document.Rev).To(Equal("1-a"))
+
+		paths := make([]string, len(issues))
+		for i, issue := range issues {
+			paths[i] = issue.Path
+		}
+		Expect(paths).To(ConsistOf("items[1].size", "items[2]", "items[3].extra", "name", "other", "doc.size"))
+		for _, issue := range issues {
+			if issue.Path == "other" || issue.Path == "items[3].extra" {
+				Expect(issue.Err).To(BeNil())
+			} else {
+				Expect(issue.Err).ToNot(BeNil())
+			}
+		}
+
+		cloudant.DisableLenientDecoding()
+		Expect(cloudant.IsLenientDecodingEnabled()).To(BeFalse())
+	})
+
+	It("Decodes arrays leniently", func() {
+		cloudant.EnableLenientDecoding(func(issue DecodingIssue) {
+			issues = append(issues, issue)
+		})
+		raw := []json.RawMessage{json.RawMessage(`{"id":"a"}`), json.RawMessage(`{"id":2}`)}
+		cloudant.prepareResponse("TestItems", &raw)
+		Expect(raw).To(HaveLen(2))
+		Expect(string(raw[1])).To(Equal(`{}`))
+		Expect(issues).To(HaveLen(1))
+		Expect(issues[0].Path).To(Equal("[1].id"))
+	})
+
+	It("Keeps responses of unregistered operations", func() {
+		cloudant.EnableLenientDecoding(func(issue DecodingIssue) {
+			issues = append(issues, issue)
+		})
+		raw := rawObject(`{"name":1}`)
+		cloudant.prepareResponse("Other", &raw)
+		Expect(raw).To(HaveKey("name"))
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("Decodes the legacy forms of models", func() {
+		raw := rawObject(`{"named":{"a":"x","b":{"name":"y"}},"analyzer":"keyword","counts":[],"name":1}`)
+		cloudant.prepareResponse("GetDesignDocument", &raw)
+		Expect(raw["named"]).To(MatchJSON(`{"a":{"name":"x"},"b":{"name":"y"}}`))
+		Expect(raw["analyzer"]).To(MatchJSON(`{"name":"keyword"}`))
+		Expect(raw["counts"]).To(MatchJSON(`{}`))
+		// malformed properties are kept without lenient decoding
+		Expect(raw["name"]).To(MatchJSON(`1`))
+
+		raw = rawObject(`{"analyzer":"keyword"}`)
+		cloudant.prepareResponse("TestModel", &raw)
+		Expect(raw["analyzer"]).To(MatchJSON(`"keyword"`))
+	})
+})
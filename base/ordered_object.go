@@ -0,0 +1,141 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ObjectMember is a member of an OrderedObject.
+type ObjectMember struct {
+	Name  string
+	Value interface{}
+}
+
+// OrderedObject is a JSON object that keeps the order of its members.
+// View collation compares the members of objects in order, so objects
+// used as view keys must be serialized with the members in the same order.
+type OrderedObject []ObjectMember
+
+// Get returns the value of the member with the name.
+func (o OrderedObject) Get(name string) (interface{}, bool) {
+	for _, member := range o {
+		if member.Name == name {
+			return member.Value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON serializes the members of the object in order.
+func (o OrderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, member := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(member.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(member.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON deserializes the members of the object in order,
+// with nested objects as OrderedObject.
+func (o *OrderedObject) UnmarshalJSON(b []byte) error {
+	v, err := decodeOrdered(b)
+	if err != nil {
+		return err
+	}
+	object, ok := v.(OrderedObject)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %s into an OrderedObject", b)
+	}
+	*o = object
+	return nil
+}
+
+// DecodeOrderedKey decodes a raw JSON view key like json.Unmarshal into an interface{},
+// except objects that are decoded as OrderedObject keeping the order of their members.
+func DecodeOrderedKey(raw json.RawMessage) (interface{}, error) {
+	return decodeOrdered(raw)
+}
+
+// decodeOrdered decodes a JSON value like json.Unmarshal into an interface{},
+// except objects that are decoded as OrderedObject.
+func decodeOrdered(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	v, err := decodeOrderedValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err == nil {
+		return nil, errors.New("invalid character after top-level value")
+	}
+	return v, nil
+}
+
+func decodeOrderedValue(dec *json.Decoder) (interface{}, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		object := make(OrderedObject, 0)
+		for dec.More() {
+			t, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, ObjectMember{Name: t.(string), Value: value})
+		}
+		_, err = dec.Token()
+		return object, err
+	case json.Delim('['):
+		array := make([]interface{}, 0)
+		for dec.More() {
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = dec.Token()
+		return array, err
+	default:
+		return t, nil
+	}
+}
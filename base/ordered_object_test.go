@@ -0,0 +1,69 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Ordered object UT`, func() {
+	It("Marshals the members in order", func() {
+		o := OrderedObject{{"type", "order"}, {"customer", "c1"}, {"address", OrderedObject{{"zip", 1}, {"city", "x"}}}}
+		b, err := json.Marshal(o)
+		Expect(err).To(BeNil())
+		Expect(string(b)).To(Equal(`{"type":"order","customer":"c1","address":{"zip":1,"city":"x"}}`))
+
+		b, err = json.Marshal(OrderedObject{})
+		Expect(err).To(BeNil())
+		Expect(string(b)).To(Equal(`{}`))
+
+		_, err = json.Marshal(OrderedObject{{"f", func() {}}})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Unmarshals the members in order", func() {
+		var o OrderedObject
+		Expect(json.Unmarshal([]byte(`{"z":[1,{"b":true,"a":null}],"a":"x"}`), &o)).To(Succeed())
+		Expect(o).To(Equal(OrderedObject{
+			{"z", []interface{}{float64(1), OrderedObject{{"b", true}, {"a", nil}}}},
+			{"a", "x"},
+		}))
+		v, ok := o.Get("a")
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("x"))
+		_, ok = o.Get("b")
+		Expect(ok).To(BeFalse())
+
+		Expect(json.Unmarshal([]byte(`[1]`), &o)).ToNot(Succeed())
+		Expect(json.Unmarshal([]byte(`{"a":}`), &o)).ToNot(Succeed())
+	})
+
+	It("Decodes view keys with ordered objects", func() {
+		key, err := DecodeOrderedKey(json.RawMessage(`["a",{"y":1,"x":2}]`))
+		Expect(err).To(BeNil())
+		Expect(key).To(Equal([]interface{}{"a", OrderedObject{{"y", float64(1)}, {"x", float64(2)}}}))
+		key, err = DecodeOrderedKey(json.RawMessage(`"b"`))
+		Expect(err).To(BeNil())
+		Expect(key).To(Equal("b"))
+
+		_, err = DecodeOrderedKey(json.RawMessage(`1 2`))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,90 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package base
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// changesQueryParams are the query parameters of the named PostChangesOptions attributes.
+var changesQueryParams = []string{
+	"att_encoding_info", "attachments", "conflicts", "descending", "feed", "filter", "heartbeat",
+	"include_docs", "limit", "seq_interval", "since", "style", "timeout", "view",
+}
+
+// queryParamsOperationIds are the operations that send additional query parameters.
+var queryParamsOperationIds = []string{
+	"PostChanges",
+	"PostChangesAsStream",
+}
+
+type queryParamsKey struct{}
+
+// WithQueryParams returns a copy of the context with additional query parameters
+// for the PostChanges and PostChangesAsStream requests made with it, for example
+// the parameters read from req.query by a design document filter function.
+// The parameters must not be the query parameters of the named PostChangesOptions attributes.
+func WithQueryParams(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, queryParamsKey{}, maps.Clone(params))
+}
+
+// QueryParams returns the additional query parameters of the context.
+func QueryParams(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(queryParamsKey{}).(map[string]string)
+	return params
+}
+
+// ValidateQueryParams returns an error if additional query parameters
+// are the query parameters of the named PostChangesOptions attributes.
+func ValidateQueryParams(params map[string]string) error {
+	invalid := make([]string, 0)
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		if slices.Contains(changesQueryParams, name) {
+			invalid = append(invalid, name)
+		}
+	}
+	if len(invalid) > 0 {
+		err := fmt.Errorf("the query parameters %s are reserved for the named options", strings.Join(invalid, ", "))
+		return core.SDKErrorf(err, "", "invalid-query-parameter", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// addQueryParams adds the additional query parameters of the request context
+// to a request of an operation that sends them.
+func addQueryParams(operationId string, req *http.Request) error {
+	params := QueryParams(req.Context())
+	if len(params) == 0 || !slices.Contains(queryParamsOperationIds, operationId) {
+		return nil
+	}
+	if err := ValidateQueryParams(params); err != nil {
+		return err
+	}
+	query := req.URL.Query()
+	for name, value := range params {
+		query.Set(name, value)
+	}
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
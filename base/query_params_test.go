@@ -0,0 +1,97 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package base
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Query parameters UT`, func() {
+	var (
+		server   *httptest.Server
+		cloudant *BaseService
+		query    url.Values
+	)
+
+	BeforeEach(func() {
+		query = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte("{}"))
+		}))
+		var err error
+		cloudant, err = NewBaseService(&core.ServiceOptions{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	request := func(ctx context.Context, operationId string) error {
+		builder := core.NewRequestBuilder(core.POST).WithContext(ctx)
+		_, err := builder.ResolveRequestURL(cloudant.Options.URL, `/db/_changes`, nil)
+		Expect(err).To(BeNil())
+		for headerName, headerValue := range common.GetSdkHeaders("cloudant", "V1", operationId) {
+			builder.AddHeader(headerName, headerValue)
+		}
+		builder.AddQuery("filter", "ddoc/byName")
+		req, err := builder.Build()
+		Expect(err).To(BeNil())
+		_, err = cloudant.Request(req, nil)
+		return err
+	}
+
+	It("Adds the query parameters of the context to changes requests", func() {
+		params := map[string]string{"name": "Jane"}
+		ctx := WithQueryParams(context.Background(), params)
+		params["name"] = "changed"
+		Expect(QueryParams(ctx)).To(Equal(map[string]string{"name": "Jane"}))
+		Expect(QueryParams(context.Background())).To(BeNil())
+
+		for _, operationId := range []string{"PostChanges", "PostChangesAsStream"} {
+			Expect(request(ctx, operationId)).To(Succeed())
+			Expect(query.Get("filter")).To(Equal("ddoc/byName"))
+			Expect(query.Get("name")).To(Equal("Jane"))
+		}
+
+		Expect(request(ctx, "PostView")).To(Succeed())
+		Expect(query.Has("name")).To(BeFalse())
+	})
+
+	It("Rejects the query parameters of the named options", func() {
+		ctx := WithQueryParams(context.Background(), map[string]string{"since": "now", "name": "Jane", "filter": "other"})
+		err := request(ctx, "PostChanges")
+		Expect(err).To(MatchError("the query parameters filter, since are reserved for the named options"))
+		Expect(query).To(BeNil())
+
+		Expect(ValidateQueryParams(map[string]string{"name": "Jane"})).To(Succeed())
+		Expect(ValidateQueryParams(nil)).To(Succeed())
+	})
+})
@@ -0,0 +1,42 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+)
+
+type rawResultKey struct{}
+
+// WithRawResult returns a copy of the context that makes the requests made with it
+// keep the members of their raw JSON object result in raw, for example to decode
+// the keys of view rows with DecodeOrderedKey in the order of their members.
+func WithRawResult(ctx context.Context, raw *map[string]json.RawMessage) context.Context {
+	return context.WithValue(ctx, rawResultKey{}, raw)
+}
+
+// keepRawResult keeps the raw JSON object result of a request in the raw result of its context.
+func keepRawResult(ctx context.Context, result interface{}) {
+	raw, ok := ctx.Value(rawResultKey{}).(*map[string]json.RawMessage)
+	if !ok || raw == nil {
+		return
+	}
+	if r, ok := result.(*map[string]json.RawMessage); ok {
+		*raw = maps.Clone(*r)
+	}
+}
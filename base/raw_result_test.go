@@ -0,0 +1,74 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package base
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Raw result UT`, func() {
+	It("Keeps the raw result of the requests made with the context", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"total_rows":1,"rows":[{"key":{"z":1,"a":2}}]}`))
+		}))
+		defer server.Close()
+		cloudant, err := NewBaseService(&core.ServiceOptions{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		request := func(ctx context.Context) map[string]json.RawMessage {
+			builder := core.NewRequestBuilder(core.POST).WithContext(ctx)
+			_, err := builder.ResolveRequestURL(cloudant.Options.URL, `/db/_design/ddoc/_view/view`, nil)
+			Expect(err).To(BeNil())
+			for headerName, headerValue := range common.GetSdkHeaders("cloudant", "V1", "PostView") {
+				builder.AddHeader(headerName, headerValue)
+			}
+			req, err := builder.Build()
+			Expect(err).To(BeNil())
+			var result map[string]json.RawMessage
+			_, err = cloudant.Request(req, &result)
+			Expect(err).To(BeNil())
+			return result
+		}
+
+		var raw map[string]json.RawMessage
+		result := request(WithRawResult(context.Background(), &raw))
+		Expect(raw).To(Equal(result))
+		var rows []struct {
+			Key json.RawMessage `json:"key"`
+		}
+		Expect(json.Unmarshal(raw["rows"], &rows)).To(Succeed())
+		key, err := DecodeOrderedKey(rows[0].Key)
+		Expect(err).To(BeNil())
+		Expect(key).To(Equal(OrderedObject{{"z", float64(1)}, {"a", float64(2)}}))
+
+		raw = nil
+		request(context.Background())
+		Expect(raw).To(BeNil())
+	})
+})
@@ -0,0 +1,473 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/mango"
+)
+
+// viewRow is a row of _all_docs or of a view.
+type viewRow struct {
+	id    string
+	key   interface{}
+	value interface{}
+}
+
+// rangeBound is the start or end of the range of a query.
+type rangeBound struct {
+	key   interface{}
+	docID *string
+}
+
+// rangeQuery is the range, order and page of rows of a query.
+type rangeQuery struct {
+	start, end   *rangeBound
+	descending   bool
+	inclusiveEnd bool
+	skip         int64
+
+	// The maximum number of rows, -1 for no limit.
+	limit int64
+}
+
+// newRangeQuery returns the range query of the params.
+func newRangeQuery(p params) rangeQuery {
+	q := rangeQuery{
+		descending:   p.bool("descending", false),
+		inclusiveEnd: p.bool("inclusive_end", true),
+		skip:         p.int("skip", 0),
+		limit:        p.int("limit", -1),
+	}
+	if key, ok := p.key("key"); ok {
+		q.start, q.end, q.inclusiveEnd = &rangeBound{key: key}, &rangeBound{key: key}, true
+		return q
+	}
+	for _, name := range []string{"start_key", "startkey"} {
+		if key, ok := p.key(name); ok {
+			q.start = &rangeBound{key: key}
+			if docID, ok := p.string("start_key_doc_id"); ok {
+				q.start.docID = &docID
+			}
+		}
+	}
+	for _, name := range []string{"end_key", "endkey"} {
+		if key, ok := p.key(name); ok {
+			q.end = &rangeBound{key: key}
+			if docID, ok := p.string("end_key_doc_id"); ok {
+				q.end.docID = &docID
+			}
+		}
+	}
+	return q
+}
+
+// apply returns the rows in the range of the query, in the order of the query,
+// after skip and up to limit rows, and the offset of the first returned row.
+// The rows must be in ascending order.
+func (q rangeQuery) apply(rows []viewRow, compare func(a, b interface{}) int) ([]viewRow, int) {
+	rows = slices.Clone(rows)
+	if q.descending {
+		slices.Reverse(rows)
+	}
+	compareBound := func(row viewRow, bound *rangeBound) int {
+		c := compare(row.key, bound.key)
+		if c != 0 || bound.docID == nil {
+			return c
+		}
+		return strings.Compare(row.id, *bound.docID)
+	}
+	// before reports if the row comes before the bound in the order of the query
+	before := func(row viewRow, bound *rangeBound) bool {
+		if q.descending {
+			return compareBound(row, bound) > 0
+		}
+		return compareBound(row, bound) < 0
+	}
+	offset := 0
+	if q.start != nil {
+		for offset < len(rows) && before(rows[offset], q.start) {
+			offset++
+		}
+	}
+	end := len(rows)
+	if q.end != nil {
+		end = offset
+		for end < len(rows) && (before(rows[end], q.end) || (q.inclusiveEnd && compareBound(rows[end], q.end) == 0)) {
+			end++
+		}
+	}
+	offset = min(offset+int(max(q.skip, 0)), end)
+	if q.limit >= 0 {
+		end = min(end, offset+int(q.limit))
+	}
+	return rows[offset:end], offset
+}
+
+// compareIDs compares the keys of _all_docs, with the raw collation of strings.
+func compareIDs(a, b interface{}) int {
+	sa, aok := a.(string)
+	sb, bok := b.(string)
+	if aok && bok {
+		return strings.Compare(sa, sb)
+	}
+	return mango.Collate(a, b)
+}
+
+// queries calls query for the queries param of a POST request to a queries endpoint
+// and writes the results, or calls query for the params of the request.
+func (r *request) queries(queriesIndex int, query func(params) (interface{}, *httpError)) *httpError {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodPost {
+		return methodNotAllowed("GET,HEAD,POST")
+	}
+	if err := r.readParams(); err != nil {
+		return err
+	}
+	if len(r.path) <= queriesIndex {
+		result, err := query(r.params)
+		if err != nil {
+			return err
+		}
+		return r.writeJSON(http.StatusOK, result)
+	}
+	if r.path[queriesIndex] != "queries" || r.Method != http.MethodPost {
+		return errMissing
+	}
+	var queries []params
+	if err := json.Unmarshal(r.params["queries"], &queries); err != nil {
+		return badRequest("`queries` must be an array of objects")
+	}
+	results := make([]interface{}, 0, len(queries))
+	for _, p := range queries {
+		result, err := query(p)
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+	}
+	return r.writeJSON(http.StatusOK, map[string]interface{}{"results": results})
+}
+
+// allDocs handles the _all_docs and _design_docs requests of a database or of a partition.
+func (s *Server) allDocs(r *request, db *database, partition string) *httpError {
+	index := 1
+	if partition != "" {
+		index = 3
+	}
+	design := r.path[index] == "_design_docs"
+	return r.queries(index+1, func(p params) (interface{}, *httpError) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return db.allDocs(p, design, partition)
+	})
+}
+
+// allDocs returns the result of an _all_docs or _design_docs query.
+func (db *database) allDocs(p params, design bool, partition string) (interface{}, *httpError) {
+	includes := func(id string) bool {
+		// _all_docs includes design documents
+		if design && !strings.HasPrefix(id, "_design/") {
+			return false
+		}
+		return partition == "" || strings.HasPrefix(id, partition+":")
+	}
+	o := docOptions{
+		attachments: p.bool("attachments", false),
+		conflicts:   p.bool("conflicts", false),
+	}
+	includeDocs := p.bool("include_docs", false)
+	row := func(doc *document) map[string]interface{} {
+		winner := doc.winner()
+		value := map[string]interface{}{"rev": winner.id}
+		row := map[string]interface{}{"id": doc.id, "key": doc.id, "value": value}
+		if winner.deleted {
+			value["deleted"] = true
+			row["doc"] = nil
+		} else if includeDocs {
+			row["doc"] = documentBody(doc, winner, o)
+		}
+		return row
+	}
+
+	var ids []viewRow
+	for _, id := range db.docIDs() {
+		if includes(id) {
+			ids = append(ids, viewRow{id: id, key: id})
+		}
+	}
+	rows := make([]map[string]interface{}, 0)
+	offset := 0
+	if keys, ok := p.keys("keys"); ok {
+		for _, key := range keys {
+			id, _ := key.(string)
+			if doc := db.docs[id]; doc != nil && includes(id) {
+				rows = append(rows, row(doc))
+			} else {
+				rows = append(rows, map[string]interface{}{"key": key, "error": "not_found"})
+			}
+		}
+	} else {
+		var selected []viewRow
+		selected, offset = newRangeQuery(p).apply(ids, compareIDs)
+		for _, r := range selected {
+			rows = append(rows, row(db.docs[r.id]))
+		}
+	}
+	result := map[string]interface{}{"total_rows": len(ids), "offset": offset, "rows": rows}
+	if p.bool("update_seq", false) {
+		result["update_seq"] = db.formatSeq(db.seq)
+	}
+	return result, nil
+}
+
+// view handles the requests of a view of a database or of a partition.
+func (s *Server) view(r *request, db *database, ddoc, name, partition string) *httpError {
+	index := 4
+	if partition != "" {
+		index = 6
+	}
+	s.mu.Lock()
+	v, ok := s.views[designPath(db.name, ddoc, name)]
+	s.mu.Unlock()
+	if !ok {
+		return &httpError{http.StatusNotFound, "not_found", "missing_named_view"}
+	}
+	return r.queries(index, func(p params) (interface{}, *httpError) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return db.view(v, p, partition)
+	})
+}
+
+// view returns the result of a view query.
+func (db *database) view(v View, p params, partition string) (interface{}, *httpError) {
+	rows, err := db.mapDocuments(v, partition)
+	if err != nil {
+		return nil, err
+	}
+	_, explicitReduce := p["reduce"]
+	reduce := p.bool("reduce", v.Reduce != "")
+	includeDocs := p.bool("include_docs", false)
+	switch {
+	case reduce && v.Reduce == "" && explicitReduce:
+		return nil, &httpError{http.StatusBadRequest, "query_parse_error", "Invalid `reduce` for map-only view."}
+	case reduce && includeDocs:
+		return nil, &httpError{http.StatusBadRequest, "query_parse_error", "`include_docs` is invalid for reduce"}
+	}
+
+	q := newRangeQuery(p)
+	page := q
+	if reduce {
+		// skip and limit apply to the reduced rows
+		q.skip, q.limit = 0, -1
+	}
+	var selected []viewRow
+	offset := 0
+	if keys, ok := p.keys("keys"); ok {
+		for _, key := range keys {
+			for _, row := range rows {
+				if mango.Collate(row.key, key) == 0 {
+					selected = append(selected, row)
+				}
+			}
+		}
+		selected, _ = rangeQuery{skip: q.skip, limit: q.limit}.apply(selected, mango.Collate)
+	} else {
+		selected, offset = q.apply(rows, mango.Collate)
+	}
+
+	if reduce {
+		reduced, err := reduceRows(v.Reduce, selected, p)
+		if err != nil {
+			return nil, err
+		}
+		reduced, _ = rangeQuery{skip: page.skip, limit: page.limit}.apply(reduced, mango.Collate)
+		result := make([]map[string]interface{}, 0, len(reduced))
+		for _, row := range reduced {
+			result = append(result, map[string]interface{}{"key": row.key, "value": row.value})
+		}
+		return map[string]interface{}{"rows": result}, nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(selected))
+	for _, row := range selected {
+		r := map[string]interface{}{"id": row.id, "key": row.key, "value": row.value}
+		if includeDocs {
+			r["doc"] = db.linkedDocument(row, p.bool("conflicts", false))
+		}
+		result = append(result, r)
+	}
+	return map[string]interface{}{"total_rows": len(rows), "offset": offset, "rows": result}, nil
+}
+
+// mapDocuments calls the map function of the view for the documents
+// and returns the emitted rows in the order of the view.
+func (db *database) mapDocuments(v View, partition string) ([]viewRow, *httpError) {
+	var rows []viewRow
+	var mapErr error
+	for _, id := range db.docIDs() {
+		if strings.HasPrefix(id, "_design/") || (partition != "" && !strings.HasPrefix(id, partition+":")) {
+			continue
+		}
+		doc := db.docs[id]
+		body, err := copyJSON(documentBody(doc, doc.winner(), docOptions{}))
+		if err != nil {
+			return nil, &httpError{http.StatusInternalServerError, "error", err.Error()}
+		}
+		v.Map(body, func(key, value interface{}) {
+			k, err := normalize(key)
+			if err != nil {
+				mapErr = err
+				return
+			}
+			val, err := normalize(value)
+			if err != nil {
+				mapErr = err
+				return
+			}
+			rows = append(rows, viewRow{id: id, key: k, value: val})
+		})
+		if mapErr != nil {
+			return nil, &httpError{http.StatusInternalServerError, "map_runtime_error", mapErr.Error()}
+		}
+	}
+	slices.SortStableFunc(rows, func(a, b viewRow) int {
+		if c := mango.Collate(a.key, b.key); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+	return rows, nil
+}
+
+// linkedDocument returns the document of a row to include,
+// the document of an _id property of the value or of the row.
+func (db *database) linkedDocument(row viewRow, conflicts bool) interface{} {
+	id := row.id
+	if value, ok := row.value.(base.OrderedObject); ok {
+		if linked, ok := value.Get("_id"); ok {
+			if linkedID, ok := linked.(string); ok {
+				id = linkedID
+			}
+		}
+	}
+	doc := db.docs[id]
+	if doc == nil || doc.winner().deleted {
+		return nil
+	}
+	return documentBody(doc, doc.winner(), docOptions{conflicts: conflicts})
+}
+
+// reduceRows groups the rows with the group and group_level params
+// and reduces the values of each group with the built-in reduce function.
+func reduceRows(reduce string, rows []viewRow, p params) ([]viewRow, *httpError) {
+	group := p.bool("group", false)
+	level := p.int("group_level", -1)
+	groupKey := func(key interface{}) interface{} {
+		if level >= 0 {
+			if array, ok := key.([]interface{}); ok && len(array) > int(level) {
+				return array[:level]
+			}
+			return key
+		}
+		if group {
+			return key
+		}
+		return nil
+	}
+
+	var groups []viewRow
+	var values []interface{}
+	flush := func() *httpError {
+		if len(values) == 0 {
+			return nil
+		}
+		value, err := reduceValues(reduce, values)
+		if err != nil {
+			return err
+		}
+		groups[len(groups)-1].value = value
+		values = nil
+		return nil
+	}
+	for _, row := range rows {
+		key := groupKey(row.key)
+		if len(groups) == 0 || mango.Collate(groups[len(groups)-1].key, key) != 0 {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			groups = append(groups, viewRow{key: key})
+		}
+		values = append(values, row.value)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// reduceValues reduces values with a built-in reduce function.
+func reduceValues(reduce string, values []interface{}) (interface{}, *httpError) {
+	if reduce == "_count" {
+		return len(values), nil
+	}
+	numbers := make([]float64, 0, len(values))
+	for _, value := range values {
+		number, ok := value.(float64)
+		if !ok {
+			return nil, &httpError{http.StatusInternalServerError, "builtin_reduce_error", fmt.Sprintf("The %s function requires that map values be numbers", reduce)}
+		}
+		numbers = append(numbers, number)
+	}
+	switch reduce {
+	case "_sum":
+		sum := 0.0
+		for _, n := range numbers {
+			sum += n
+		}
+		return sum, nil
+	case "_stats":
+		stats := map[string]float64{"sum": 0, "count": 0, "min": math.Inf(1), "max": math.Inf(-1), "sumsqr": 0}
+		for _, n := range numbers {
+			stats["sum"] += n
+			stats["count"]++
+			stats["min"] = math.Min(stats["min"], n)
+			stats["max"] = math.Max(stats["max"], n)
+			stats["sumsqr"] += n * n
+		}
+		return stats, nil
+	}
+	return nil, &httpError{http.StatusBadRequest, "invalid_design_doc", fmt.Sprintf("Unsupported built-in reduce function %s", reduce)}
+}
+
+// copyJSON returns a deep copy of a JSON object.
+func copyJSON(v map[string]interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var c map[string]interface{}
+	err = json.Unmarshal(b, &c)
+	return c, err
+}
@@ -0,0 +1,148 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"fmt"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/features"
+	"github.com/IBM/cloudant-go-sdk/viewquery"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// pageSizes returns the sizes of the pages of a pagination.
+func pageSizes[T features.PaginatedRow](pagination features.Pagination[T]) []int {
+	var sizes []int
+	for page, err := range pagination.Pages() {
+		Expect(err).ShouldNot(HaveOccurred())
+		sizes = append(sizes, len(page))
+	}
+	return sizes
+}
+
+var _ = Describe(`Fake server all docs and view tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+		for i := range 7 {
+			putDocument(service, "db", fmt.Sprintf("doc%d", i), newDocument(map[string]interface{}{
+				"type":  []string{"a", "b"}[i%2],
+				"count": i,
+			}))
+		}
+		_, _, err := service.PutDesignDocument(service.NewPutDesignDocumentOptions("db", "ddoc", &cloudantv1.DesignDocument{}))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Pages _all_docs and _design_docs`, func() {
+		pagination := features.NewAllDocsPagination(service, service.NewPostAllDocsOptions("db").SetLimit(3))
+		Expect(pageSizes(pagination)).To(Equal([]int{3, 3, 2}))
+
+		var ids []string
+		for row, err := range features.NewAllDocsPagination(service, service.NewPostAllDocsOptions("db").
+			SetLimit(3).SetStartKey("doc2").SetEndKey("doc5").SetDescending(false)).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			ids = append(ids, *row.ID)
+		}
+		Expect(ids).To(Equal([]string{"doc2", "doc3", "doc4", "doc5"}))
+
+		designDocs := features.NewDesignDocsPagination(service, service.NewPostDesignDocsOptions("db").SetLimit(3))
+		Expect(pageSizes(designDocs)).To(Equal([]int{1}))
+	})
+
+	It(`Pages _all_dbs`, func() {
+		for _, name := range []string{"db1", "db2", "db3"} {
+			_, _, err := service.PutDatabase(service.NewPutDatabaseOptions(name))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		pagination := features.NewAllDbsPagination(service, service.NewGetAllDbsOptions().SetLimit(3))
+		Expect(pageSizes(pagination)).To(Equal([]int{3, 1}))
+	})
+
+	It(`Pages views of Go map functions`, func() {
+		server.AddView("db", "ddoc", "by_type", cloudanttest.View{
+			Map: func(doc map[string]interface{}, emit func(key, value interface{})) {
+				emit([]interface{}{doc["type"], doc["count"]}, doc["count"])
+			},
+			Reduce: "_sum",
+		})
+
+		var keys []interface{}
+		for row, err := range features.NewViewPagination(service, service.NewPostViewOptions("db", "ddoc", "by_type").
+			SetLimit(2).SetReduce(false)).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			keys = append(keys, row.Key)
+		}
+		Expect(keys).To(HaveLen(7))
+		Expect(keys[0]).To(Equal([]interface{}{"a", float64(0)}))
+		Expect(keys[3]).To(Equal([]interface{}{"a", float64(6)}))
+		Expect(keys[4]).To(Equal([]interface{}{"b", float64(1)}))
+
+		result, _, err := service.PostView(service.NewPostViewOptions("db", "ddoc", "by_type").SetGroupLevel(1))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Rows).To(HaveLen(2))
+		Expect(result.Rows[0].Key).To(Equal([]interface{}{"a"}))
+		sum, err := viewquery.Sum(result.Rows[0])
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sum).To(Equal(float64(12)))
+
+		opts, err := viewquery.New("db", "ddoc", "by_type").Prefix("b").Reduce(false).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		result, _, err = service.PostView(opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Rows).To(HaveLen(3))
+	})
+
+	It(`Collates object keys of views in member order`, func() {
+		server.AddView("db", "ddoc", "by_object", cloudanttest.View{
+			Map: func(doc map[string]interface{}, emit func(key, value interface{})) {
+				emit(base.OrderedObject{{Name: "type", Value: doc["type"]}, {Name: "count", Value: doc["count"]}}, nil)
+			},
+		})
+		result, _, err := service.PostView(service.NewPostViewOptions("db", "ddoc", "by_object").
+			SetStartKey(base.OrderedObject{{Name: "type", Value: "b"}}).SetLimit(1))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Rows).To(HaveLen(1))
+		Expect(*result.Rows[0].ID).To(Equal("doc1"))
+		Expect(result.Rows[0].Key).To(Equal(map[string]interface{}{"type": "b", "count": float64(1)}))
+	})
+
+	It(`Pages partitions`, func() {
+		_, _, err := service.PutDatabase(service.NewPutDatabaseOptions("partitioned").SetPartitioned(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		for i := range 5 {
+			putDocument(service, "partitioned", fmt.Sprintf("p%d:doc%d", i%2, i), newDocument(nil))
+		}
+		pagination := features.NewAllDocsPagination(service, service.NewPostPartitionAllDocsOptions("partitioned", "p0").SetLimit(2))
+		Expect(pageSizes(pagination)).To(Equal([]int{2, 1}))
+
+		info, _, err := service.GetPartitionInformation(service.NewGetPartitionInformationOptions("partitioned", "p1"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*info.DocCount).To(BeEquivalentTo(2))
+	})
+})
@@ -0,0 +1,117 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"encoding/base64"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var errMissingAttachment = &httpError{http.StatusNotFound, "not_found", "Document is missing attachment"}
+
+// attachment handles the requests of an attachment of a document.
+func (s *Server) attachment(r *request, db *database) *httpError {
+	id, name := r.path[1], strings.Join(r.path[2:], "/")
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		doc := db.docs[id]
+		if doc == nil {
+			return errMissing
+		}
+		rev := doc.winner()
+		if id, ok := r.params.string("rev"); ok {
+			rev = doc.revisions[id]
+		}
+		if rev == nil || rev.deleted {
+			return errMissing
+		}
+		att := rev.attachments[name]
+		if att == nil {
+			return errMissingAttachment
+		}
+		r.w.Header().Set("Content-Type", att.contentType)
+		r.w.Header().Set("Content-Length", strconv.Itoa(len(att.data)))
+		r.w.Header().Set("ETag", strconv.Quote(strings.TrimPrefix(att.digest, "md5-")))
+		r.w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = r.w.Write(att.data)
+		}
+		return nil
+	case http.MethodPut:
+		data, err := r.readBody()
+		if err != nil {
+			return err
+		}
+		att := map[string]interface{}{
+			"content_type": r.Header.Get("Content-Type"),
+			"data":         base64.StdEncoding.EncodeToString(data),
+		}
+		rev, err := s.writeAttachment(db, id, r.rev(nil), name, att)
+		if err != nil {
+			return err
+		}
+		return r.writeDocumentResult(http.StatusCreated, id, rev.id)
+	case http.MethodDelete:
+		rev, err := s.writeAttachment(db, id, r.rev(nil), name, nil)
+		if err != nil {
+			return err
+		}
+		return r.writeDocumentResult(http.StatusOK, id, rev.id)
+	}
+	return methodNotAllowed("DELETE,GET,HEAD,PUT")
+}
+
+// writeAttachment writes a revision of a document with the inline attachment,
+// or without the attachment when it is nil, and notifies the changes.
+func (s *Server) writeAttachment(db *database, id, rev, name string, att map[string]interface{}) (*revision, *httpError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body := make(map[string]interface{})
+	atts := make(map[string]interface{})
+	if doc := db.docs[id]; doc != nil {
+		parent := doc.winner()
+		if rev != "" {
+			parent = doc.revisions[rev]
+		}
+		if parent != nil && !parent.deleted {
+			maps.Copy(body, parent.body)
+			for n := range parent.attachments {
+				atts[n] = map[string]interface{}{"stub": true}
+			}
+		}
+	}
+	if att != nil {
+		atts[name] = att
+	} else {
+		if _, ok := atts[name]; !ok {
+			return nil, errMissingAttachment
+		}
+		delete(atts, name)
+	}
+	body["_attachments"] = atts
+	next, err := db.write(id, rev, body)
+	if err != nil {
+		return nil, err
+	}
+	s.notify()
+	return next, nil
+}
@@ -0,0 +1,319 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"cmp"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/mango"
+)
+
+// defaultChangesTimeout is the timeout of longpoll and continuous changes requests without a timeout parameter.
+const defaultChangesTimeout = time.Minute
+
+// formatSeq returns the opaque sequence of an update of the database.
+func (db *database) formatSeq(seq int64) string {
+	if seq == 0 {
+		return "0"
+	}
+	sum := md5.Sum(fmt.Appendf(nil, "%s-%d", db.name, seq))
+	return fmt.Sprintf("%d-%s", seq, hex.EncodeToString(sum[:12]))
+}
+
+// parseSeq returns the update number of a sequence, or of the current update for now.
+func (db *database) parseSeq(p params) (int64, *httpError) {
+	if _, ok := p["since"]; !ok {
+		return 0, nil
+	}
+	since, ok := p.string("since")
+	if !ok {
+		since = string(p["since"])
+	}
+	if since == "now" {
+		return db.seq, nil
+	}
+	n, _, _ := strings.Cut(since, "-")
+	seq, err := strconv.ParseInt(n, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, badRequest("Malformed sequence supplied in 'since' parameter.")
+	}
+	return seq, nil
+}
+
+// changesFilter reports if a document passes the filter of a changes request.
+type changesFilter func(doc *document) bool
+
+// changesQuery is a changes request.
+type changesQuery struct {
+	filter      changesFilter
+	allLeaves   bool
+	descending  bool
+	includeDocs bool
+	docOptions  docOptions
+	limit       int64
+}
+
+// changesFilter returns the filter of the params of a changes request.
+func (s *Server) changesFilter(r *request, db *database) (changesFilter, *httpError) {
+	filter, _ := r.params.string("filter")
+	switch filter {
+	case "":
+		return func(*document) bool { return true }, nil
+	case "_doc_ids":
+		ids := r.params.strings("doc_ids")
+		return func(doc *document) bool { return slices.Contains(ids, doc.id) }, nil
+	case "_design":
+		return func(doc *document) bool { return strings.HasPrefix(doc.id, "_design/") }, nil
+	case "_selector":
+		var selectorJSON map[string]interface{}
+		if err := json.Unmarshal(r.params["selector"], &selectorJSON); err != nil || selectorJSON == nil {
+			return nil, badRequest("Selector must be specified in POST payload")
+		}
+		selector, err := mango.Compile(selectorJSON)
+		if err != nil {
+			return nil, badRequest("%s", err)
+		}
+		return func(doc *document) bool {
+			return selector.Matches(filterBody(doc))
+		}, nil
+	case "_view":
+		name, _ := r.params.string("view")
+		ddoc, view, _ := strings.Cut(name, "/")
+		v, ok := s.views[designPath(db.name, ddoc, view)]
+		if !ok {
+			return nil, &httpError{http.StatusNotFound, "not_found", "missing_named_view"}
+		}
+		return func(doc *document) bool {
+			emitted := false
+			v.Map(filterBody(doc), func(key, value interface{}) { emitted = true })
+			return emitted
+		}, nil
+	}
+	ddoc, name, _ := strings.Cut(filter, "/")
+	f, ok := s.filters[designPath(db.name, ddoc, name)]
+	if !ok {
+		return nil, &httpError{http.StatusNotFound, "not_found", "missing json filter function"}
+	}
+	query := r.URL.Query()
+	return func(doc *document) bool {
+		return f(filterBody(doc), query)
+	}, nil
+}
+
+// filterBody returns a copy of the winning revision of a document for a filter.
+func filterBody(doc *document) map[string]interface{} {
+	body, _ := copyJSON(documentBody(doc, doc.winner(), docOptions{}))
+	return body
+}
+
+// changes returns the changes of the database after the since sequence,
+// the last sequence and the number of pending changes.
+// It must be called with the lock held.
+func (db *database) changes(q changesQuery, since int64) ([]map[string]interface{}, int64, int64) {
+	docs := make([]*document, 0, len(db.docs))
+	for _, doc := range db.docs {
+		if q.descending || doc.seq > since {
+			docs = append(docs, doc)
+		}
+	}
+	slices.SortFunc(docs, func(a, b *document) int {
+		if q.descending {
+			return cmp.Compare(b.seq, a.seq)
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	results := make([]map[string]interface{}, 0)
+	lastSeq := db.seq
+	if q.descending {
+		lastSeq = 0
+	}
+	for i, doc := range docs {
+		if q.limit >= 0 && int64(len(results)) >= q.limit {
+			break
+		}
+		if !q.filter(doc) {
+			if q.descending {
+				lastSeq = doc.seq
+			}
+			continue
+		}
+		winner := doc.winner()
+		changes := []map[string]interface{}{{"rev": winner.id}}
+		if q.allLeaves {
+			for _, rev := range doc.conflicts(false) {
+				changes = append(changes, map[string]interface{}{"rev": rev})
+			}
+			for _, rev := range doc.conflicts(true) {
+				changes = append(changes, map[string]interface{}{"rev": rev})
+			}
+		}
+		result := map[string]interface{}{"seq": db.formatSeq(doc.seq), "id": doc.id, "changes": changes}
+		if winner.deleted {
+			result["deleted"] = true
+		}
+		if q.includeDocs {
+			result["doc"] = documentBody(doc, winner, q.docOptions)
+		}
+		results = append(results, result)
+		if q.limit >= 0 && int64(len(results)) == q.limit {
+			lastSeq = doc.seq
+			if !q.descending {
+				return results, lastSeq, int64(len(docs) - i - 1)
+			}
+		}
+	}
+	return results, lastSeq, 0
+}
+
+// changes handles the requests of the changes feed of a database.
+func (s *Server) changes(r *request, db *database) *httpError {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		return methodNotAllowed("GET,POST")
+	}
+	if err := r.readParams(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	filter, err := s.changesFilter(r, db)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	since, err := db.parseSeq(r.params)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	style, _ := r.params.string("style")
+	q := changesQuery{
+		filter:      filter,
+		allLeaves:   style == "all_docs",
+		descending:  r.params.bool("descending", false),
+		includeDocs: r.params.bool("include_docs", false),
+		docOptions:  r.params.docOptions(),
+		limit:       r.params.int("limit", -1),
+	}
+	timeout := time.Duration(r.params.int("timeout", defaultChangesTimeout.Milliseconds())) * time.Millisecond
+	feed, _ := r.params.string("feed")
+	switch feed {
+	case "", "normal":
+		s.mu.Lock()
+		results, lastSeq, pending := db.changes(q, since)
+		s.mu.Unlock()
+		return r.writeJSON(http.StatusOK, changesResult(db, results, lastSeq, pending))
+	case "longpoll":
+		deadline := time.After(timeout)
+		for {
+			s.mu.Lock()
+			results, lastSeq, pending := db.changes(q, since)
+			changed := s.changed
+			s.mu.Unlock()
+			if len(results) > 0 {
+				return r.writeJSON(http.StatusOK, changesResult(db, results, lastSeq, pending))
+			}
+			select {
+			case <-changed:
+			case <-deadline:
+				return r.writeJSON(http.StatusOK, changesResult(db, results, lastSeq, pending))
+			case <-s.closed:
+				return r.writeJSON(http.StatusOK, changesResult(db, results, lastSeq, pending))
+			case <-r.Context().Done():
+				return nil
+			}
+		}
+	case "continuous":
+		return s.continuousChanges(r, db, q, since, timeout)
+	}
+	return badRequest("Supported `feed` types: normal, continuous, longpoll")
+}
+
+// continuousChanges writes the changes of the database as lines of JSON until the
+// timeout or the limit, with newlines as heartbeats with the heartbeat parameter.
+func (s *Server) continuousChanges(r *request, db *database, q changesQuery, since int64, timeout time.Duration) *httpError {
+	r.w.Header().Set("Content-Type", "application/json")
+	r.w.WriteHeader(http.StatusOK)
+	flusher, _ := r.w.(http.Flusher)
+	flush := func() {
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	write := func(v interface{}) {
+		b, _ := json.Marshal(v)
+		_, _ = r.w.Write(append(b, '\n'))
+		flush()
+	}
+	flush()
+	heartbeat := make(<-chan time.Time)
+	if interval := r.params.int("heartbeat", 0); interval > 0 {
+		ticker := time.NewTicker(time.Duration(interval) * time.Millisecond)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+	deadline := time.After(timeout)
+	lastSeq, pending := since, int64(0)
+	for {
+		s.mu.Lock()
+		var results []map[string]interface{}
+		results, lastSeq, pending = db.changes(q, lastSeq)
+		changed := s.changed
+		s.mu.Unlock()
+		for _, result := range results {
+			write(result)
+		}
+		if q.limit >= 0 {
+			q.limit -= int64(len(results))
+			if q.limit == 0 {
+				write(map[string]interface{}{"last_seq": db.formatSeq(lastSeq), "pending": pending})
+				return nil
+			}
+		}
+	wait:
+		for {
+			select {
+			case <-changed:
+				break wait
+			case <-heartbeat:
+				_, _ = r.w.Write([]byte("\n"))
+				flush()
+			case <-deadline:
+				write(map[string]interface{}{"last_seq": db.formatSeq(lastSeq), "pending": pending})
+				return nil
+			case <-s.closed:
+				write(map[string]interface{}{"last_seq": db.formatSeq(lastSeq), "pending": pending})
+				return nil
+			case <-r.Context().Done():
+				return nil
+			}
+		}
+	}
+}
+
+func changesResult(db *database, results []map[string]interface{}, lastSeq, pending int64) map[string]interface{} {
+	return map[string]interface{}{"results": results, "last_seq": db.formatSeq(lastSeq), "pending": pending}
+}
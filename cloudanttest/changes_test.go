@@ -0,0 +1,161 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/features"
+	"github.com/IBM/cloudant-go-sdk/selector"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// changeIDs returns the document IDs of the first n changes of a follower channel.
+func changeIDs(changes <-chan features.ChangesItem, n int) []string {
+	var ids []string
+	for len(ids) < n {
+		var change features.ChangesItem
+		Eventually(changes, 5*time.Second).Should(Receive(&change))
+		item, err := change.Item()
+		Expect(err).ShouldNot(HaveOccurred())
+		ids = append(ids, *item.ID)
+	}
+	return ids
+}
+
+var _ = Describe(`Fake server changes tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+		for i := range 7 {
+			putDocument(service, "db", fmt.Sprintf("doc%d", i), newDocument(map[string]interface{}{"count": i}))
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Pages changes with sequences`, func() {
+		pagination := features.NewChangesPagination(service, service.NewPostChangesOptions("db").SetLimit(3))
+		Expect(pageSizes(pagination)).To(Equal([]int{3, 3, 1}))
+
+		result, _, err := service.PostChanges(service.NewPostChangesOptions("db").SetSince("now"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Results).To(BeEmpty())
+		Expect(*result.LastSeq).To(HavePrefix("7-"))
+
+		putDocument(service, "db", "doc7", newDocument(nil))
+		result, _, err = service.PostChanges(service.NewPostChangesOptions("db").SetSince(*result.LastSeq))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Results).To(HaveLen(1))
+		Expect(*result.Results[0].ID).To(Equal("doc7"))
+		Expect(*result.Results[0].Seq).To(HavePrefix("8-"))
+	})
+
+	It(`Follows changes with longpoll requests`, func() {
+		// the follower starts from the current sequence, instead of now which is the sequence of its first request
+		current, _, err := service.PostChanges(service.NewPostChangesOptions("db").SetSince("now"))
+		Expect(err).ShouldNot(HaveOccurred())
+		follower, err := features.NewChangesFollower(service, service.NewPostChangesOptions("db").
+			SetSince(*current.LastSeq).
+			SetFilter("_selector").
+			SetSelector(selector.Field("count", selector.Gte(10)).MustBuild()))
+		Expect(err).ShouldNot(HaveOccurred())
+		changes, err := follower.Start()
+		Expect(err).ShouldNot(HaveOccurred())
+		defer follower.Stop()
+
+		putDocument(service, "db", "doc8", newDocument(map[string]interface{}{"count": 1}))
+		putDocument(service, "db", "doc9", newDocument(map[string]interface{}{"count": 10}))
+		putDocument(service, "db", "doc10", newDocument(map[string]interface{}{"count": 11}))
+		Expect(changeIDs(changes, 2)).To(Equal([]string{"doc9", "doc10"}))
+	})
+
+	It(`Follows changes with filter functions and query parameters`, func() {
+		server.AddFilter("db", "ddoc", "above", func(doc map[string]interface{}, params url.Values) bool {
+			count, _ := doc["count"].(float64)
+			return fmt.Sprint(count) > params.Get("min")
+		})
+		ctx := base.WithQueryParams(context.Background(), map[string]string{"min": "4"})
+		follower, err := features.NewFilterFunctionChangesFollowerWithContext(ctx, service, service.NewPostChangesOptions("db").
+			SetFilter("ddoc/above"))
+		Expect(err).ShouldNot(HaveOccurred())
+		changes, err := follower.StartOneOff()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changeIDs(changes, 2)).To(Equal([]string{"doc5", "doc6"}))
+		Eventually(changes).Should(BeClosed())
+	})
+
+	It(`Streams continuous changes`, func() {
+		stream, _, err := service.PostChangesAsStream(service.NewPostChangesOptions("db").
+			SetFeed(cloudantv1.PostChangesOptionsFeedContinuousConst).
+			SetSince("now").
+			SetLimit(2))
+		Expect(err).ShouldNot(HaveOccurred())
+		defer stream.Close()
+
+		putDocument(service, "db", "doc7", newDocument(nil))
+		putDocument(service, "db", "doc8", newDocument(nil))
+		scanner := bufio.NewScanner(stream)
+		var lines []map[string]interface{}
+		for scanner.Scan() {
+			line := make(map[string]interface{})
+			Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0]["id"]).To(Equal("doc7"))
+		Expect(lines[1]["id"]).To(Equal("doc8"))
+		Expect(lines[2]["last_seq"]).To(HavePrefix("9-"))
+	})
+
+	It(`Lists the leaves of conflicting documents`, func() {
+		rev, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc0"))
+		Expect(err).ShouldNot(HaveOccurred())
+		branch := newDocument(map[string]interface{}{"count": 100})
+		branch.ID = rev.ID
+		branch.Rev = func() *string { s := "2-zzz"; return &s }()
+		_, _, err = service.PostBulkDocs(service.NewPostBulkDocsOptions("db").SetBulkDocs(&cloudantv1.BulkDocs{
+			Docs:     []cloudantv1.Document{*branch},
+			NewEdits: func() *bool { b := false; return &b }(),
+		}))
+		Expect(err).ShouldNot(HaveOccurred())
+		putDocument(service, "db", "doc0", rev)
+
+		result, _, err := service.PostChanges(service.NewPostChangesOptions("db").
+			SetStyle("all_docs").
+			SetDocIds([]string{"doc0"}).
+			SetFilter("_doc_ids"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Results).To(HaveLen(1))
+		Expect(result.Results[0].Changes).To(HaveLen(2))
+		Expect(*result.Results[0].Changes[0].Rev).To(Equal("2-zzz"))
+	})
+})
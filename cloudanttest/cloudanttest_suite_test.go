@@ -0,0 +1,62 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"testing"
+
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCloudanttest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cloudanttest Suite")
+}
+
+// newService returns a service of the server with a database.
+func newService(server *cloudanttest.Server, db string) *cloudantv1.CloudantV1 {
+	service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	if db != "" {
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions(db))
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+	return service
+}
+
+// putDocument creates or updates a document and returns its revision.
+func putDocument(service *cloudantv1.CloudantV1, db, id string, doc *cloudantv1.Document) string {
+	result, _, err := service.PutDocument(service.NewPutDocumentOptions(db, id).SetDocument(doc))
+	Expect(err).ShouldNot(HaveOccurred())
+	return *result.Rev
+}
+
+// newDocument returns a document with the properties.
+func newDocument(properties map[string]interface{}) *cloudantv1.Document {
+	doc := &cloudantv1.Document{}
+	for name, value := range properties {
+		doc.SetProperty(name, value)
+	}
+	return doc
+}
@@ -0,0 +1,38 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+)
+
+// decodeKey decodes a JSON view key with objects as base.OrderedObject.
+func decodeKey(raw json.RawMessage) (interface{}, error) {
+	return base.DecodeOrderedKey(raw)
+}
+
+// normalize returns a Go value emitted by a map function as it is
+// decoded from JSON, with objects as base.OrderedObject.
+func normalize(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeKey(b)
+}
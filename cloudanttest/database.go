@@ -0,0 +1,439 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// attachment is the content of an attachment of a revision.
+type attachment struct {
+	contentType string
+	data        []byte
+	digest      string
+	revpos      int64
+}
+
+// revision is a revision of a document.
+type revision struct {
+	// The revision ID, for example 2-967a00dff5e02add41819138abb3284d.
+	id string
+
+	// The revision IDs from this revision to the first revision.
+	history []string
+
+	// The properties of the document without the special _ properties.
+	body map[string]interface{}
+
+	attachments map[string]*attachment
+	deleted     bool
+}
+
+func (r *revision) pos() int64 {
+	return revisionPos(r.id)
+}
+
+// document is a document with its tree of revisions.
+type document struct {
+	id string
+
+	// The leaf revisions of the revision tree.
+	leaves []*revision
+
+	// All revisions of the document by revision ID.
+	revisions map[string]*revision
+
+	// The sequence of the last update of the document.
+	seq int64
+}
+
+// winner returns the winning revision of the document, the non-deleted
+// leaf with the highest position and revision ID.
+func (d *document) winner() *revision {
+	var winner *revision
+	for _, leaf := range d.leaves {
+		if winner == nil || compareRevisions(leaf, winner) > 0 {
+			winner = leaf
+		}
+	}
+	return winner
+}
+
+// conflicts returns the revision IDs of the leaves other than the winner
+// that are deleted or not.
+func (d *document) conflicts(deleted bool) []string {
+	winner := d.winner()
+	conflicts := make([]string, 0)
+	for _, leaf := range d.leaves {
+		if leaf != winner && leaf.deleted == deleted {
+			conflicts = append(conflicts, leaf.id)
+		}
+	}
+	slices.SortFunc(conflicts, func(a, b string) int {
+		return compareRevisions(d.revisions[b], d.revisions[a])
+	})
+	return conflicts
+}
+
+func compareRevisions(a, b *revision) int {
+	if a.deleted != b.deleted {
+		if a.deleted {
+			return -1
+		}
+		return 1
+	}
+	if a.pos() != b.pos() {
+		if a.pos() < b.pos() {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a.id, b.id)
+}
+
+// localDocument is a _local document.
+type localDocument struct {
+	rev  int64
+	body map[string]interface{}
+}
+
+// database is an in-memory database.
+type database struct {
+	name        string
+	partitioned bool
+	docs        map[string]*document
+	local       map[string]*localDocument
+	seq         int64
+}
+
+func newDatabase(name string, partitioned bool) *database {
+	return &database{
+		name:        name,
+		partitioned: partitioned,
+		docs:        make(map[string]*document),
+		local:       make(map[string]*localDocument),
+	}
+}
+
+// docIDs returns the IDs of the documents with a non-deleted winning revision, in order.
+func (db *database) docIDs() []string {
+	ids := make([]string, 0, len(db.docs))
+	for id, doc := range db.docs {
+		if !doc.winner().deleted {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// counts returns the number of documents and deleted documents.
+func (db *database) counts() (int, int) {
+	count, deleted := 0, 0
+	for _, doc := range db.docs {
+		if doc.winner().deleted {
+			deleted++
+		} else {
+			count++
+		}
+	}
+	return count, deleted
+}
+
+// write stores a new revision of a document from the body of a write request,
+// replacing the rev leaf revision, the winning revision when rev is empty.
+func (db *database) write(id, rev string, body map[string]interface{}) (*revision, *httpError) {
+	if err := validateDocID(id, db.partitioned); err != nil {
+		return nil, err
+	}
+	doc, exists := db.docs[id]
+	var parent *revision
+	switch {
+	case exists && rev != "":
+		parent = doc.revisions[rev]
+		if parent == nil || !slices.Contains(doc.leaves, parent) {
+			return nil, errConflict
+		}
+	case exists:
+		parent = doc.winner()
+		if !parent.deleted {
+			return nil, errConflict
+		}
+	case rev != "":
+		return nil, errConflict
+	}
+
+	next := &revision{body: make(map[string]interface{}), attachments: make(map[string]*attachment)}
+	for name, value := range body {
+		switch name {
+		case "_deleted":
+			next.deleted = value == true
+		case "_attachments":
+		default:
+			if strings.HasPrefix(name, "_") {
+				continue
+			}
+			next.body[name] = value
+		}
+	}
+	pos := int64(1)
+	if parent != nil {
+		pos = parent.pos() + 1
+	}
+	if err := next.setAttachments(body["_attachments"], parent, pos); err != nil {
+		return nil, err
+	}
+	parentID := ""
+	if parent != nil {
+		parentID = parent.id
+	}
+	next.id = fmt.Sprintf("%d-%s", pos, revisionHash(parentID, next))
+	if parent != nil {
+		next.history = append([]string{next.id}, parent.history...)
+	} else {
+		next.history = []string{next.id}
+	}
+	db.store(id, next, parent)
+	return next, nil
+}
+
+// replicate stores a revision with its history as it is, the new_edits=false write.
+func (db *database) replicate(id string, body map[string]interface{}) *httpError {
+	if err := validateDocID(id, db.partitioned); err != nil {
+		return err
+	}
+	rev, _ := body["_rev"].(string)
+	if revisionPos(rev) == 0 {
+		return &httpError{400, "bad_request", "Invalid rev format"}
+	}
+	history := []string{rev}
+	if value, ok := body["_revisions"]; ok {
+		var err *httpError
+		if history, err = revisionsHistory(value, rev); err != nil {
+			return err
+		}
+	}
+	doc := db.docs[id]
+	if doc != nil && doc.revisions[rev] != nil {
+		// the revision exists already
+		return nil
+	}
+	next := &revision{id: rev, history: history, body: make(map[string]interface{}), attachments: make(map[string]*attachment)}
+	for name, value := range body {
+		switch {
+		case name == "_deleted":
+			next.deleted = value == true
+		case !strings.HasPrefix(name, "_"):
+			next.body[name] = value
+		}
+	}
+	var parent *revision
+	if doc != nil && len(history) > 1 {
+		parent = doc.revisions[history[1]]
+	}
+	if err := next.setAttachments(body["_attachments"], parent, next.pos()); err != nil {
+		return err
+	}
+	db.store(id, next, parent)
+	return nil
+}
+
+// revisionsHistory returns the revision IDs of the _revisions of a revision,
+// starting with the revision itself.
+func revisionsHistory(value interface{}, rev string) ([]string, *httpError) {
+	revisions, _ := value.(map[string]interface{})
+	start, _ := revisions["start"].(float64)
+	ids, _ := revisions["ids"].([]interface{})
+	if len(ids) == 0 || start != float64(int64(start)) || int64(start) < int64(len(ids)) {
+		return nil, badRequest("Invalid _revisions")
+	}
+	history := make([]string, 0, len(ids))
+	for i, id := range ids {
+		hash, ok := id.(string)
+		if !ok || hash == "" {
+			return nil, badRequest("Invalid _revisions")
+		}
+		history = append(history, fmt.Sprintf("%d-%s", int64(start)-int64(i), hash))
+	}
+	if history[0] != rev {
+		return nil, badRequest("The _revisions do not start with the _rev %s", rev)
+	}
+	return history, nil
+}
+
+// store adds the revision to the document, replacing its ancestors in the leaves.
+func (db *database) store(id string, next, parent *revision) {
+	doc, exists := db.docs[id]
+	if !exists {
+		doc = &document{id: id, revisions: make(map[string]*revision)}
+		db.docs[id] = doc
+	}
+	doc.revisions[next.id] = next
+	doc.leaves = slices.DeleteFunc(doc.leaves, func(leaf *revision) bool {
+		return leaf == parent || slices.Contains(next.history[1:], leaf.id)
+	})
+	doc.leaves = append(doc.leaves, next)
+	db.seq++
+	doc.seq = db.seq
+}
+
+// setAttachments sets the attachments of a new revision from the _attachments of the request body,
+// inline attachments with base64 data and stubs of the attachments of the parent revision.
+func (r *revision) setAttachments(value interface{}, parent *revision, pos int64) *httpError {
+	if value == nil {
+		return nil
+	}
+	atts, ok := value.(map[string]interface{})
+	if !ok {
+		return &httpError{400, "bad_request", "Attachments must be an object"}
+	}
+	for name, value := range atts {
+		att, _ := value.(map[string]interface{})
+		if att["stub"] == true {
+			if parent == nil || parent.attachments[name] == nil {
+				return &httpError{412, "missing_stub", fmt.Sprintf("Invalid attachment stub for %s", name)}
+			}
+			r.attachments[name] = parent.attachments[name]
+			continue
+		}
+		encoded, _ := att["data"].(string)
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return &httpError{400, "bad_request", fmt.Sprintf("Invalid attachment data for %s", name)}
+		}
+		contentType, _ := att["content_type"].(string)
+		r.attachments[name] = newAttachment(contentType, data, pos)
+	}
+	return nil
+}
+
+func newAttachment(contentType string, data []byte, revpos int64) *attachment {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	sum := md5.Sum(data)
+	return &attachment{
+		contentType: contentType,
+		data:        data,
+		digest:      "md5-" + base64.StdEncoding.EncodeToString(sum[:]),
+		revpos:      revpos,
+	}
+}
+
+// documentBody returns the JSON body of a revision of a document.
+func documentBody(doc *document, rev *revision, o docOptions) map[string]interface{} {
+	body := make(map[string]interface{}, len(rev.body)+4)
+	maps.Copy(body, rev.body)
+	body["_id"] = doc.id
+	body["_rev"] = rev.id
+	if rev.deleted {
+		body["_deleted"] = true
+	}
+	if len(rev.attachments) > 0 {
+		atts := make(map[string]interface{}, len(rev.attachments))
+		for name, att := range rev.attachments {
+			a := map[string]interface{}{
+				"content_type": att.contentType,
+				"digest":       att.digest,
+				"length":       len(att.data),
+				"revpos":       att.revpos,
+			}
+			if o.attachments && !slices.ContainsFunc(o.attsSince, func(since string) bool {
+				return revisionPos(since) >= att.revpos && slices.Contains(rev.history, since)
+			}) {
+				a["data"] = base64.StdEncoding.EncodeToString(att.data)
+			} else {
+				a["stub"] = true
+			}
+			atts[name] = a
+		}
+		body["_attachments"] = atts
+	}
+	if o.conflicts && rev == doc.winner() {
+		if conflicts := doc.conflicts(false); len(conflicts) > 0 {
+			body["_conflicts"] = conflicts
+		}
+	}
+	if o.deletedConflicts && rev == doc.winner() {
+		if conflicts := doc.conflicts(true); len(conflicts) > 0 {
+			body["_deleted_conflicts"] = conflicts
+		}
+	}
+	if o.revs {
+		ids := make([]string, len(rev.history))
+		for i, id := range rev.history {
+			_, ids[i], _ = strings.Cut(id, "-")
+		}
+		body["_revisions"] = map[string]interface{}{"start": rev.pos(), "ids": ids}
+	}
+	return body
+}
+
+// docOptions are the options of reading a document.
+type docOptions struct {
+	attachments      bool
+	attsSince        []string
+	conflicts        bool
+	deletedConflicts bool
+	revs             bool
+}
+
+func revisionPos(rev string) int64 {
+	pos, _, ok := strings.Cut(rev, "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(pos, 10, 64)
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
+func revisionHash(parent string, r *revision) string {
+	b, _ := json.Marshal(r.body)
+	digests := make(map[string]string, len(r.attachments))
+	for name, att := range r.attachments {
+		digests[name] = att.digest
+	}
+	d, _ := json.Marshal(digests)
+	sum := md5.Sum(fmt.Appendf(nil, "%s%t%s%s", parent, r.deleted, b, d))
+	return hex.EncodeToString(sum[:])
+}
+
+func validateDocID(id string, partitioned bool) *httpError {
+	if id == "" {
+		return &httpError{400, "illegal_docid", "Document id must not be empty"}
+	}
+	if strings.HasPrefix(id, "_") && !strings.HasPrefix(id, "_design/") {
+		return &httpError{400, "illegal_docid", "Only reserved document ids may start with underscore."}
+	}
+	if partitioned && !strings.HasPrefix(id, "_design/") {
+		if partition, _, ok := strings.Cut(id, ":"); !ok || partition == "" {
+			return &httpError{400, "illegal_docid", "Doc id must be of form partition:id"}
+		}
+	}
+	return nil
+}
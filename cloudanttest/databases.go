@@ -0,0 +1,174 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// databaseNamePattern is the pattern of valid database names.
+var databaseNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_$()+/-]*$`)
+
+// database handles the requests of a database.
+func (s *Server) database(r *request) *httpError {
+	name := r.path[0]
+	switch r.Method {
+	case http.MethodPut:
+		if !databaseNamePattern.MatchString(name) {
+			return &httpError{http.StatusBadRequest, "illegal_database_name", fmt.Sprintf("Name: '%s'. Only lowercase characters (a-z), digits (0-9), and any of the characters _, $, (, ), +, -, and / are allowed. Must begin with a letter.", name)}
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.dbs[name] != nil {
+			return &httpError{http.StatusPreconditionFailed, "file_exists", "The database could not be created, the file already exists."}
+		}
+		s.dbs[name] = newDatabase(name, r.params.bool("partitioned", false))
+		return r.writeJSON(http.StatusCreated, map[string]interface{}{"ok": true})
+	case http.MethodDelete:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.dbs[name] == nil {
+			return errNoDatabase
+		}
+		delete(s.dbs, name)
+		s.notify()
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true})
+	case http.MethodGet, http.MethodHead:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		db := s.dbs[name]
+		if db == nil {
+			return errNoDatabase
+		}
+		return r.writeJSON(http.StatusOK, db.information())
+	case http.MethodPost:
+		s.mu.Lock()
+		db := s.dbs[name]
+		s.mu.Unlock()
+		if db == nil {
+			return errNoDatabase
+		}
+		return s.postDocument(r, db)
+	}
+	return methodNotAllowed("DELETE,GET,HEAD,POST,PUT")
+}
+
+// information returns the information of the database.
+func (db *database) information() map[string]interface{} {
+	count, deleted := db.counts()
+	props := map[string]interface{}{}
+	if db.partitioned {
+		props["partitioned"] = true
+	}
+	return map[string]interface{}{
+		"db_name":             db.name,
+		"update_seq":          db.formatSeq(db.seq),
+		"doc_count":           count,
+		"doc_del_count":       deleted,
+		"sizes":               map[string]interface{}{"active": 0, "external": 0, "file": 0},
+		"props":               props,
+		"cluster":             map[string]interface{}{"n": 1, "q": 1, "r": 1, "w": 1},
+		"compact_running":     false,
+		"disk_format_version": 8,
+		"instance_start_seq":  "0",
+		"purge_seq":           "0",
+		"engine":              "couch_bt_engine",
+	}
+}
+
+// partitionInformation writes the information of a partition of a database.
+func (s *Server) partitionInformation(r *request, db *database, partition string) *httpError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count, deleted := 0, 0
+	for id, doc := range db.docs {
+		if !strings.HasPrefix(id, partition+":") {
+			continue
+		}
+		if doc.winner().deleted {
+			deleted++
+		} else {
+			count++
+		}
+	}
+	return r.writeJSON(http.StatusOK, map[string]interface{}{
+		"db_name":       db.name,
+		"partition":     partition,
+		"doc_count":     count,
+		"doc_del_count": deleted,
+		"sizes":         map[string]interface{}{"active": 0, "external": 0},
+	})
+}
+
+// allDbs writes the names of the databases.
+func (s *Server) allDbs(r *request) *httpError {
+	s.mu.Lock()
+	names := make([]string, 0, len(s.dbs))
+	for name := range s.dbs {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	slices.Sort(names)
+
+	q := rangeQuery{
+		descending:   r.params.bool("descending", false),
+		inclusiveEnd: true,
+		skip:         r.params.int("skip", 0),
+		limit:        r.params.int("limit", -1),
+	}
+	if key, ok := r.params.string("start_key"); ok {
+		q.start = &rangeBound{key: key}
+	}
+	if key, ok := r.params.string("end_key"); ok {
+		q.end = &rangeBound{key: key}
+	}
+	rows := make([]viewRow, len(names))
+	for i, name := range names {
+		rows[i] = viewRow{key: name}
+	}
+	rows, _ = q.apply(rows, compareIDs)
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.key.(string)
+	}
+	return r.writeJSON(http.StatusOK, result)
+}
+
+// dbsInfo writes the information of the databases of the keys.
+func (s *Server) dbsInfo(r *request) *httpError {
+	var body struct {
+		Keys []string `json:"keys"`
+	}
+	if err := r.readJSON(&body); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]map[string]interface{}, 0, len(body.Keys))
+	for _, key := range body.Keys {
+		if db := s.dbs[key]; db != nil {
+			result = append(result, map[string]interface{}{"key": key, "info": db.information()})
+		} else {
+			result = append(result, map[string]interface{}{"key": key, "error": "not_found"})
+		}
+	}
+	return r.writeJSON(http.StatusOK, result)
+}
@@ -0,0 +1,475 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// document handles the requests of a document.
+func (s *Server) document(r *request, db *database) *httpError {
+	id := r.path[1]
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return db.getDocument(r, id)
+	case http.MethodPut:
+		var body map[string]interface{}
+		if err := r.readJSON(&body); err != nil {
+			return err
+		}
+		if !r.params.bool("new_edits", true) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if err := db.replicate(id, body); err != nil {
+				return err
+			}
+			s.notify()
+			rev, _ := body["_rev"].(string)
+			return r.writeDocumentResult(http.StatusCreated, id, rev)
+		}
+		rev, err := s.writeDocument(db, id, r.rev(body), body)
+		if err != nil {
+			return err
+		}
+		return r.writeDocumentResult(http.StatusCreated, id, rev.id)
+	case http.MethodDelete:
+		s.mu.Lock()
+		exists := db.docs[id] != nil
+		s.mu.Unlock()
+		if !exists {
+			return errMissing
+		}
+		rev, err := s.writeDocument(db, id, r.rev(nil), map[string]interface{}{"_deleted": true})
+		if err != nil {
+			return err
+		}
+		return r.writeDocumentResult(http.StatusOK, id, rev.id)
+	}
+	return methodNotAllowed("DELETE,GET,HEAD,PUT")
+}
+
+// postDocument creates a document with the body of a POST request to a database.
+func (s *Server) postDocument(r *request, db *database) *httpError {
+	var body map[string]interface{}
+	if err := r.readJSON(&body); err != nil {
+		return err
+	}
+	id, _ := body["_id"].(string)
+	if id == "" {
+		id = newToken()
+	}
+	rev, err := s.writeDocument(db, id, r.rev(body), body)
+	if err != nil {
+		return err
+	}
+	return r.writeDocumentResult(http.StatusCreated, id, rev.id)
+}
+
+// writeDocument writes a revision of a document and notifies the changes.
+func (s *Server) writeDocument(db *database, id, rev string, body map[string]interface{}) (*revision, *httpError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next, err := db.write(id, rev, body)
+	if err != nil {
+		return nil, err
+	}
+	s.notify()
+	return next, nil
+}
+
+// rev returns the revision of a write request, from the rev query parameter,
+// the If-Match header or the _rev property of the body.
+func (r *request) rev(body map[string]interface{}) string {
+	if rev, ok := r.params.string("rev"); ok {
+		return rev
+	}
+	if rev := strings.Trim(r.Header.Get("If-Match"), `"`); rev != "" {
+		return rev
+	}
+	rev, _ := body["_rev"].(string)
+	return rev
+}
+
+func (r *request) writeDocumentResult(status int, id, rev string) *httpError {
+	r.w.Header().Set("ETag", strconv.Quote(rev))
+	return r.writeJSON(status, map[string]interface{}{"ok": true, "id": id, "rev": rev})
+}
+
+// docOptions returns the options of reading a document from the params.
+func (p params) docOptions() docOptions {
+	return docOptions{
+		attachments:      p.bool("attachments", false),
+		attsSince:        p.strings("atts_since"),
+		conflicts:        p.bool("conflicts", false) || p.bool("meta", false),
+		deletedConflicts: p.bool("deleted_conflicts", false) || p.bool("meta", false),
+		revs:             p.bool("revs", false),
+	}
+}
+
+// getDocument writes a revision of a document, or the open revisions
+// of the document with the open_revs parameter.
+// It must be called with the lock held.
+func (db *database) getDocument(r *request, id string) *httpError {
+	doc := db.docs[id]
+	if doc == nil {
+		return errMissing
+	}
+	o := r.params.docOptions()
+	if _, ok := r.params["open_revs"]; ok {
+		var revs []string
+		if all, _ := r.params.string("open_revs"); all != "all" {
+			if err := json.Unmarshal(r.params["open_revs"], &revs); err != nil {
+				return badRequest("Invalid open_revs")
+			}
+		}
+		result := make([]map[string]interface{}, 0)
+		for _, rev := range doc.openRevisions(revs, r.params.bool("latest", false)) {
+			if rev.revision == nil {
+				result = append(result, map[string]interface{}{"missing": rev.id})
+			} else {
+				result = append(result, map[string]interface{}{"ok": documentBody(doc, rev.revision, o)})
+			}
+		}
+		return r.writeJSON(http.StatusOK, result)
+	}
+	rev := doc.winner()
+	if id, ok := r.params.string("rev"); ok {
+		rev = doc.revisions[id]
+		if rev == nil {
+			return errMissing
+		}
+	} else if rev.deleted {
+		return errDeleted
+	}
+	r.w.Header().Set("ETag", strconv.Quote(rev.id))
+	return r.writeJSON(http.StatusOK, documentBody(doc, rev, o))
+}
+
+// openRevision is a requested revision of a document, with a nil revision when it is missing.
+type openRevision struct {
+	id       string
+	revision *revision
+}
+
+// openRevisions returns the revisions of the revision IDs, or the leaves when
+// there are no revision IDs. With latest the leaves that descend from the
+// revisions are returned instead.
+func (d *document) openRevisions(revs []string, latest bool) []openRevision {
+	var result []openRevision
+	if revs == nil {
+		for _, leaf := range d.leaves {
+			result = append(result, openRevision{leaf.id, leaf})
+		}
+		slices.SortFunc(result, func(a, b openRevision) int {
+			return compareRevisions(b.revision, a.revision)
+		})
+		return result
+	}
+	for _, id := range revs {
+		if latest {
+			var found bool
+			for _, leaf := range d.leaves {
+				if slices.Contains(leaf.history, id) {
+					result = append(result, openRevision{leaf.id, leaf})
+					found = true
+				}
+			}
+			if !found {
+				result = append(result, openRevision{id, nil})
+			}
+			continue
+		}
+		result = append(result, openRevision{id, d.revisions[id]})
+	}
+	return result
+}
+
+// bulkDocs writes the documents of a _bulk_docs request.
+func (s *Server) bulkDocs(r *request, db *database) *httpError {
+	var body struct {
+		Docs     []map[string]interface{} `json:"docs"`
+		NewEdits *bool                    `json:"new_edits"`
+	}
+	if err := r.readJSON(&body); err != nil {
+		return err
+	}
+	if body.Docs == nil {
+		return badRequest("POST body must include `docs` parameter.")
+	}
+	newEdits := body.NewEdits == nil || *body.NewEdits
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	results := make([]map[string]interface{}, 0, len(body.Docs))
+	for _, doc := range body.Docs {
+		id, _ := doc["_id"].(string)
+		if id == "" && newEdits {
+			id = newToken()
+		}
+		if !newEdits {
+			if err := db.replicate(id, doc); err != nil {
+				results = append(results, map[string]interface{}{"id": id, "error": err.err, "reason": err.reason})
+			}
+			continue
+		}
+		rev, _ := doc["_rev"].(string)
+		next, err := db.write(id, rev, doc)
+		if err != nil {
+			results = append(results, map[string]interface{}{"id": id, "error": err.err, "reason": err.reason})
+			continue
+		}
+		results = append(results, map[string]interface{}{"ok": true, "id": id, "rev": next.id})
+	}
+	s.notify()
+	return r.writeJSON(http.StatusCreated, results)
+}
+
+// bulkGet reads the revisions of the documents of a _bulk_get request.
+// Only JSON responses are supported.
+func (s *Server) bulkGet(r *request, db *database) *httpError {
+	if accept := r.Header.Get("Accept"); strings.HasPrefix(accept, "multipart/") {
+		return &httpError{http.StatusNotAcceptable, "not_acceptable", "The fake server only returns JSON _bulk_get responses"}
+	}
+	var body struct {
+		Docs []struct {
+			ID        string   `json:"id"`
+			Rev       string   `json:"rev"`
+			AttsSince []string `json:"atts_since"`
+		} `json:"docs"`
+	}
+	if err := r.readJSON(&body); err != nil {
+		return err
+	}
+	o := r.params.docOptions()
+	latest := r.params.bool("latest", false)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	results := make([]map[string]interface{}, 0, len(body.Docs))
+	for _, d := range body.Docs {
+		docs := make([]map[string]interface{}, 0)
+		missing := func(rev string) map[string]interface{} {
+			return map[string]interface{}{"error": map[string]interface{}{"id": d.ID, "rev": rev, "error": "not_found", "reason": "missing"}}
+		}
+		doc := db.docs[d.ID]
+		switch {
+		case doc == nil && d.Rev == "":
+			docs = append(docs, missing("undefined"))
+		case doc == nil:
+			docs = append(docs, missing(d.Rev))
+		case d.Rev == "":
+			docs = append(docs, map[string]interface{}{"ok": documentBody(doc, doc.winner(), o)})
+		default:
+			ro := o
+			ro.attsSince = append(slices.Clone(o.attsSince), d.AttsSince...)
+			for _, rev := range doc.openRevisions([]string{d.Rev}, latest) {
+				if rev.revision == nil {
+					docs = append(docs, missing(rev.id))
+				} else {
+					docs = append(docs, map[string]interface{}{"ok": documentBody(doc, rev.revision, ro)})
+				}
+			}
+		}
+		results = append(results, map[string]interface{}{"id": d.ID, "docs": docs})
+	}
+	return r.writeJSON(http.StatusOK, map[string]interface{}{"results": results})
+}
+
+// localDocument handles the requests of a _local document.
+func (s *Server) localDocument(r *request, db *database) *httpError {
+	id := r.path[1]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	local := db.local[id]
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		if local == nil {
+			return errMissing
+		}
+		body := make(map[string]interface{}, len(local.body)+2)
+		for name, value := range local.body {
+			body[name] = value
+		}
+		body["_id"] = id
+		body["_rev"] = local.formatRev()
+		return r.writeJSON(http.StatusOK, body)
+	case http.MethodPut:
+		var body map[string]interface{}
+		if err := r.readJSON(&body); err != nil {
+			return err
+		}
+		if local != nil && r.rev(body) != local.formatRev() {
+			return errConflict
+		}
+		if local == nil {
+			local = &localDocument{}
+			db.local[id] = local
+		}
+		local.rev++
+		local.body = make(map[string]interface{}, len(body))
+		for name, value := range body {
+			if !strings.HasPrefix(name, "_") {
+				local.body[name] = value
+			}
+		}
+		return r.writeJSON(http.StatusCreated, map[string]interface{}{"ok": true, "id": id, "rev": local.formatRev()})
+	case http.MethodDelete:
+		if local == nil {
+			return errMissing
+		}
+		if r.rev(nil) != local.formatRev() {
+			return errConflict
+		}
+		delete(db.local, id)
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true, "id": id, "rev": "0-0"})
+	}
+	return methodNotAllowed("DELETE,GET,HEAD,PUT")
+}
+
+func (l *localDocument) formatRev() string {
+	return fmt.Sprintf("0-%d", l.rev)
+}
+
+// index handles the requests of the _index endpoint. Indexes are stored
+// in design documents with the query language, but _find doesn't use them.
+func (s *Server) index(r *request, db *database) *httpError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && len(r.path) == 2:
+		return r.writeJSON(http.StatusOK, db.indexes())
+	case r.Method == http.MethodPost && len(r.path) == 2:
+		var body struct {
+			Index       map[string]interface{} `json:"index"`
+			Ddoc        string                 `json:"ddoc"`
+			Name        string                 `json:"name"`
+			Partitioned *bool                  `json:"partitioned"`
+		}
+		if err := r.readJSON(&body); err != nil {
+			return err
+		}
+		fields, ok := body.Index["fields"].([]interface{})
+		if !ok || len(fields) == 0 {
+			return badRequest("Missing required key: fields")
+		}
+		def, _ := json.Marshal(body.Index)
+		sum := md5.Sum(def)
+		hash := hex.EncodeToString(sum[:])
+		if body.Name == "" {
+			body.Name = hash
+		}
+		if body.Ddoc == "" {
+			body.Ddoc = hash
+		}
+		id := "_design/" + strings.TrimPrefix(body.Ddoc, "_design/")
+		ddoc := map[string]interface{}{"language": "query", "views": map[string]interface{}{}}
+		rev := ""
+		if doc := db.docs[id]; doc != nil && !doc.winner().deleted {
+			winner := doc.winner()
+			views, _ := winner.body["views"].(map[string]interface{})
+			if existing, ok := views[body.Name]; ok {
+				if options, _ := existing.(map[string]interface{})["options"].(map[string]interface{}); jsonEqual(options["def"], body.Index) {
+					return r.writeJSON(http.StatusOK, map[string]interface{}{"result": "exists", "id": id, "name": body.Name})
+				}
+			}
+			ddoc, _ = copyJSON(winner.body)
+			rev = winner.id
+		}
+		if _, ok := ddoc["views"].(map[string]interface{}); !ok {
+			ddoc["views"] = map[string]interface{}{}
+		}
+		ddoc["views"].(map[string]interface{})[body.Name] = map[string]interface{}{
+			"map":     map[string]interface{}{"fields": fields},
+			"reduce":  "_count",
+			"options": map[string]interface{}{"def": body.Index},
+		}
+		if body.Partitioned != nil {
+			ddoc["options"] = map[string]interface{}{"partitioned": *body.Partitioned}
+		}
+		if _, err := db.write(id, rev, ddoc); err != nil {
+			return err
+		}
+		s.notify()
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"result": "created", "id": id, "name": body.Name})
+	case r.Method == http.MethodDelete && len(r.path) == 5:
+		id := "_design/" + strings.TrimPrefix(r.path[2], "_design/")
+		doc := db.docs[id]
+		if doc == nil || doc.winner().deleted {
+			return &httpError{http.StatusNotFound, "not_found", "Index not found"}
+		}
+		winner := doc.winner()
+		ddoc, _ := copyJSON(winner.body)
+		views, _ := ddoc["views"].(map[string]interface{})
+		if _, ok := views[r.path[4]]; !ok {
+			return &httpError{http.StatusNotFound, "not_found", "Index not found"}
+		}
+		delete(views, r.path[4])
+		if len(views) == 0 {
+			ddoc = map[string]interface{}{"_deleted": true}
+		}
+		if _, err := db.write(id, winner.id, ddoc); err != nil {
+			return err
+		}
+		s.notify()
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true})
+	}
+	return methodNotAllowed("DELETE,GET,POST")
+}
+
+// indexes returns the indexes of the database, from the design documents with the query language.
+func (db *database) indexes() map[string]interface{} {
+	indexes := []map[string]interface{}{{
+		"ddoc": nil,
+		"name": "_all_docs",
+		"type": "special",
+		"def":  map[string]interface{}{"fields": []interface{}{map[string]interface{}{"_id": "asc"}}},
+	}}
+	for _, id := range db.docIDs() {
+		winner := db.docs[id].winner()
+		if !strings.HasPrefix(id, "_design/") || winner.body["language"] != "query" {
+			continue
+		}
+		views, _ := winner.body["views"].(map[string]interface{})
+		for _, name := range slices.Sorted(maps.Keys(views)) {
+			options, _ := views[name].(map[string]interface{})["options"].(map[string]interface{})
+			indexes = append(indexes, map[string]interface{}{
+				"ddoc":        id,
+				"name":        name,
+				"type":        "json",
+				"partitioned": db.partitioned,
+				"def":         options["def"],
+			})
+		}
+	}
+	return map[string]interface{}{"total_rows": len(indexes), "indexes": indexes}
+}
+
+func jsonEqual(a, b interface{}) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
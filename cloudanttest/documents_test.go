@@ -0,0 +1,246 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fake server document tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Creates, updates and deletes documents with revisions`, func() {
+		rev1 := putDocument(service, "db", "doc1", newDocument(map[string]interface{}{"name": "a"}))
+		Expect(rev1).To(HavePrefix("1-"))
+
+		doc, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc1"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.ID).To(Equal("doc1"))
+		Expect(*doc.Rev).To(Equal(rev1))
+		Expect(doc.GetProperty("name")).To(Equal("a"))
+
+		doc.SetProperty("name", "b")
+		rev2 := putDocument(service, "db", "doc1", doc)
+		Expect(rev2).To(HavePrefix("2-"))
+
+		// a write with the first revision conflicts with the second revision
+		_, response, err := service.PutDocument(service.NewPutDocumentOptions("db", "doc1").
+			SetDocument(newDocument(map[string]interface{}{"name": "c"})).SetRev(rev1))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+		deleted, _, err := service.DeleteDocument(service.NewDeleteDocumentOptions("db", "doc1").SetRev(rev2))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*deleted.Rev).To(HavePrefix("3-"))
+
+		_, response, err = service.GetDocument(service.NewGetDocumentOptions("db", "doc1"))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+
+		// the previous revisions can still be read
+		old, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc1").SetRev(rev1))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(old.GetProperty("name")).To(Equal("a"))
+
+		info, _, err := service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*info.DocCount).To(BeEquivalentTo(0))
+		Expect(*info.DocDelCount).To(BeEquivalentTo(1))
+		Expect(*info.UpdateSeq).To(HavePrefix("3-"))
+	})
+
+	It(`Generates IDs of posted documents`, func() {
+		result, response, err := service.PostDocument(service.NewPostDocumentOptions("db").
+			SetDocument(newDocument(map[string]interface{}{"name": "a"})))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusCreated))
+		Expect(*result.ID).To(HaveLen(32))
+
+		response, err = service.HeadDocument(service.NewHeadDocumentOptions("db", *result.ID))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.Headers.Get("ETag")).To(Equal(`"` + *result.Rev + `"`))
+	})
+
+	It(`Picks the winner of conflicting revisions`, func() {
+		rev1 := putDocument(service, "db", "doc1", newDocument(map[string]interface{}{"v": 1}))
+		_, id1, _ := strings.Cut(rev1, "-")
+		branch := func(id string, v int) cloudantv1.Document {
+			doc := *newDocument(map[string]interface{}{"v": v})
+			doc.ID = core.StringPtr("doc1")
+			doc.Rev = core.StringPtr("2-" + id)
+			doc.Revisions = &cloudantv1.Revisions{Ids: []string{id, id1}, Start: core.Int64Ptr(2)}
+			return doc
+		}
+		_, _, err := service.PostBulkDocs(service.NewPostBulkDocsOptions("db").SetBulkDocs(&cloudantv1.BulkDocs{
+			Docs:     []cloudantv1.Document{branch("aaa", 2), branch("bbb", 3)},
+			NewEdits: core.BoolPtr(false),
+		}))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		doc, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc1").SetConflicts(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Rev).To(Equal("2-bbb"))
+		Expect(doc.Conflicts).To(Equal([]string{"2-aaa"}))
+
+		// deleting the winner makes the other branch win
+		_, _, err = service.DeleteDocument(service.NewDeleteDocumentOptions("db", "doc1").SetRev("2-bbb"))
+		Expect(err).ShouldNot(HaveOccurred())
+		doc, _, err = service.GetDocument(service.NewGetDocumentOptions("db", "doc1").SetDeletedConflicts(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Rev).To(Equal("2-aaa"))
+		Expect(doc.DeletedConflicts).To(HaveLen(1))
+		Expect(doc.DeletedConflicts[0]).To(HavePrefix("3-"))
+	})
+
+	It(`Rejects invalid revisions of replicated documents`, func() {
+		for _, c := range []struct {
+			revisions *cloudantv1.Revisions
+			reason    string
+		}{
+			{&cloudantv1.Revisions{Ids: []string{}, Start: core.Int64Ptr(2)}, "Invalid _revisions"},
+			{&cloudantv1.Revisions{Ids: []string{"bbb", "aaa"}, Start: core.Int64Ptr(1)}, "Invalid _revisions"},
+			{&cloudantv1.Revisions{Ids: []string{"bbb"}}, "Invalid _revisions"},
+			{&cloudantv1.Revisions{Ids: []string{"ccc", "aaa"}, Start: core.Int64Ptr(2)}, "The _revisions do not start with the _rev 2-bbb"},
+			{&cloudantv1.Revisions{Ids: []string{"bbb", "aaa"}, Start: core.Int64Ptr(3)}, "The _revisions do not start with the _rev 2-bbb"},
+		} {
+			doc := *newDocument(nil)
+			doc.ID = core.StringPtr("doc1")
+			doc.Rev = core.StringPtr("2-bbb")
+			doc.Revisions = c.revisions
+			results, _, err := service.PostBulkDocs(service.NewPostBulkDocsOptions("db").SetBulkDocs(&cloudantv1.BulkDocs{
+				Docs:     []cloudantv1.Document{doc},
+				NewEdits: core.BoolPtr(false),
+			}))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(*results[0].Error).To(Equal("bad_request"))
+			Expect(*results[0].Reason).To(Equal(c.reason))
+		}
+		_, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc1"))
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It(`Writes and reads documents in bulk`, func() {
+		rev := putDocument(service, "db", "doc1", newDocument(nil))
+		doc2 := newDocument(nil)
+		doc2.ID = core.StringPtr("doc2")
+		stale := newDocument(nil)
+		stale.ID = core.StringPtr("doc1")
+		results, _, err := service.PostBulkDocs(service.NewPostBulkDocsOptions("db").SetBulkDocs(&cloudantv1.BulkDocs{
+			Docs: []cloudantv1.Document{*doc2, *stale},
+		}))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+		Expect(*results[0].Ok).To(BeTrue())
+		Expect(*results[1].Error).To(Equal("conflict"))
+
+		query1, _ := service.NewBulkGetQueryDocument("doc1")
+		query1.Rev = &rev
+		query2, _ := service.NewBulkGetQueryDocument("missing")
+		result, _, err := service.PostBulkGet(service.NewPostBulkGetOptions("db", []cloudantv1.BulkGetQueryDocument{*query1, *query2}))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Results).To(HaveLen(2))
+		Expect(*result.Results[0].Docs[0].Ok.Rev).To(Equal(rev))
+		Expect(*result.Results[1].Docs[0].Error.Error).To(Equal("not_found"))
+	})
+
+	It(`Stores attachments with the revisions of documents`, func() {
+		result, _, err := service.PutAttachment(service.NewPutAttachmentOptions("db", "doc1", "file.txt",
+			io.NopCloser(strings.NewReader("hello")), "text/plain"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		doc, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc1"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Attachments["file.txt"].Stub).To(BeTrue())
+		Expect(*doc.Attachments["file.txt"].Length).To(BeEquivalentTo(5))
+
+		// updates keep the stubs of attachments
+		doc.SetProperty("name", "a")
+		putDocument(service, "db", "doc1", doc)
+
+		body, response, err := service.GetAttachment(service.NewGetAttachmentOptions("db", "doc1", "file.txt"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.Headers.Get("Content-Type")).To(Equal("text/plain"))
+		data, err := io.ReadAll(body)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).To(Equal("hello"))
+
+		doc, _, err = service.GetDocument(service.NewGetDocumentOptions("db", "doc1").SetAttachments(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Attachments["file.txt"].Data).To(Equal([]byte("hello")))
+		Expect(*doc.Attachments["file.txt"].Revpos).To(BeEquivalentTo(1))
+
+		_, _, err = service.DeleteAttachment(service.NewDeleteAttachmentOptions("db", "doc1", "file.txt").SetRev(*doc.Rev))
+		Expect(err).ShouldNot(HaveOccurred())
+		_, response, err = service.GetAttachment(service.NewGetAttachmentOptions("db", "doc1", "file.txt").SetRev(*result.Rev))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		_, response, err = service.GetAttachment(service.NewGetAttachmentOptions("db", "doc1", "file.txt"))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It(`Stores local documents outside of the changes feed`, func() {
+		result, _, err := service.PutLocalDocument(service.NewPutLocalDocumentOptions("db", "checkpoint").
+			SetDocument(newDocument(map[string]interface{}{"seq": "1"})))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*result.Rev).To(Equal("0-1"))
+
+		doc, _, err := service.GetLocalDocument(service.NewGetLocalDocumentOptions("db", "checkpoint"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.ID).To(Equal("_local/checkpoint"))
+		Expect(doc.GetProperty("seq")).To(Equal("1"))
+
+		_, response, err := service.PutLocalDocument(service.NewPutLocalDocumentOptions("db", "checkpoint").
+			SetDocument(newDocument(map[string]interface{}{"seq": "2"})))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+		changes, _, err := service.PostChanges(service.NewPostChangesOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changes.Results).To(BeEmpty())
+	})
+
+	It(`Returns errors of missing databases`, func() {
+		_, response, err := service.GetDocument(service.NewGetDocumentOptions("missing", "doc1"))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(err.Error()).To(ContainSubstring("Database does not exist."))
+
+		_, response, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusPreconditionFailed))
+	})
+})
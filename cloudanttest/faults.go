@@ -0,0 +1,271 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// AnyOperation matches the requests of any operation in the faults of a FaultInjector.
+const AnyOperation = "*"
+
+// Fault is a fault injected into a response by a FaultInjector.
+// The zero Fault sends the request unchanged.
+type Fault struct {
+	// Delay delays the response, or fails the request with the
+	// error of its context when the context is done before.
+	Delay time.Duration
+	// StatusCode responds with an error status code, for example 429 or 503,
+	// and a Cloudant JSON error body without sending the request.
+	StatusCode int
+	// Header is the header added to the response of a StatusCode fault,
+	// for example a Retry-After header.
+	Header http.Header
+	// Reset fails the request with a connection reset error without sending it.
+	Reset bool
+	// Truncate fails the read of the response body with io.ErrUnexpectedEOF
+	// after Truncate bytes, when greater than 0.
+	Truncate int
+}
+
+// FaultInjector is an http.RoundTripper injecting faults into the responses of
+// the requests of the operations of a service, identified by their operation ID,
+// for example PostChanges.
+//
+// The scripted faults of an operation are injected into its next requests in order.
+// Otherwise a probabilistic fault is injected with its probability, drawn from a
+// random source seeded with Seed for repeatable tests.
+//
+// Use it with the SetHTTPClient method of the service, which wraps it in the
+// transport of base.NewErrorResponse as for any transport, and with the retries
+// enabled by EnableRetries:
+//
+//	faults := cloudanttest.NewFaultInjector(nil).Script("PostChanges", cloudanttest.Fault{StatusCode: 503})
+//	service.Service.SetHTTPClient(&http.Client{Transport: faults})
+type FaultInjector struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	scripts  map[string][]Fault
+	randoms  []randomFault
+	rand     *rand.Rand
+	requests map[string]int
+	injected map[string]int
+}
+
+// randomFault is a fault injected with a probability.
+type randomFault struct {
+	operation   string
+	probability float64
+	fault       Fault
+}
+
+// NewFaultInjector returns a FaultInjector sending the requests with a transport,
+// http.DefaultTransport when nil.
+func NewFaultInjector(next http.RoundTripper) *FaultInjector {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &FaultInjector{
+		next:     next,
+		scripts:  make(map[string][]Fault),
+		rand:     rand.New(rand.NewSource(1)),
+		requests: make(map[string]int),
+		injected: make(map[string]int),
+	}
+}
+
+// Script appends faults to inject into the next requests of an operation,
+// or of any operation for AnyOperation, one fault per request. The faults of
+// the operation are injected before the ones of AnyOperation.
+// Use the zero Fault to send a request unchanged.
+func (f *FaultInjector) Script(operation string, faults ...Fault) *FaultInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.scripts[operation] = append(f.scripts[operation], faults...)
+	return f
+}
+
+// Random injects a fault into the requests of an operation with a probability
+// between 0 and 1. The first matching fault drawn is injected.
+func (f *FaultInjector) Random(operation string, probability float64, fault Fault) *FaultInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.randoms = append(f.randoms, randomFault{operation: operation, probability: probability, fault: fault})
+	return f
+}
+
+// Seed seeds the random source of the probabilistic faults.
+func (f *FaultInjector) Seed(seed int64) *FaultInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rand = rand.New(rand.NewSource(seed))
+	return f
+}
+
+// Requests returns the number of requests of an operation, or of all
+// operations for AnyOperation.
+func (f *FaultInjector) Requests(operation string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests[operation]
+}
+
+// Injected returns the number of faults injected into the requests of an
+// operation, or of all operations for AnyOperation.
+func (f *FaultInjector) Injected(operation string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.injected[operation]
+}
+
+// RoundTrip implements RoundTripper interface
+func (f *FaultInjector) RoundTrip(req *http.Request) (*http.Response, error) {
+	fault, ok := f.fault(operationID(req))
+	if !ok {
+		return f.next.RoundTrip(req)
+	}
+
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+	if fault.Reset {
+		closeBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}
+	if fault.StatusCode != 0 {
+		closeBody(req)
+		return faultResponse(req, fault), nil
+	}
+
+	resp, err := f.next.RoundTrip(req)
+	if err != nil || fault.Truncate <= 0 {
+		return resp, err
+	}
+	resp.Body = &truncatedBody{ReadCloser: resp.Body, remaining: fault.Truncate}
+	return resp, nil
+}
+
+// fault returns the fault to inject into a request of an operation, if any.
+func (f *FaultInjector) fault(operation string) (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests[operation]++
+	f.requests[AnyOperation]++
+
+	var fault Fault
+	var ok bool
+	for _, name := range []string{operation, AnyOperation} {
+		if script := f.scripts[name]; len(script) > 0 {
+			fault, ok = script[0], true
+			f.scripts[name] = script[1:]
+			break
+		}
+	}
+	if !ok {
+		for _, random := range f.randoms {
+			if (random.operation == operation || random.operation == AnyOperation) && f.rand.Float64() < random.probability {
+				fault, ok = random.fault, true
+				break
+			}
+		}
+	}
+	if !ok || fault.isZero() {
+		return Fault{}, false
+	}
+	f.injected[operation]++
+	f.injected[AnyOperation]++
+	return fault, true
+}
+
+func (fault Fault) isZero() bool {
+	return fault.Delay == 0 && fault.StatusCode == 0 && !fault.Reset && fault.Truncate <= 0
+}
+
+// faultResponse returns the response of a StatusCode fault with a
+// Cloudant JSON error body, for example too_many_requests for 429.
+func faultResponse(req *http.Request, fault Fault) *http.Response {
+	text := http.StatusText(fault.StatusCode)
+	body, _ := json.Marshal(map[string]string{
+		"error":  strings.ReplaceAll(strings.ToLower(text), " ", "_"),
+		"reason": "Injected fault.",
+	})
+	header := fault.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fault.StatusCode, text),
+		StatusCode:    fault.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// closeBody closes the body of a request that isn't sent, as a transport does.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+// truncatedBody fails the read of a response body after some bytes.
+type truncatedBody struct {
+	io.ReadCloser
+	remaining int
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if len(p) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= n
+	if err == io.EOF {
+		return n, err
+	}
+	if b.remaining <= 0 && err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
@@ -0,0 +1,181 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/features"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fault injector tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+	var faults *cloudanttest.FaultInjector
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+		putDocument(service, "db", "doc", newDocument(nil))
+		faults = cloudanttest.NewFaultInjector(nil)
+		service.Service.SetHTTPClient(&http.Client{Transport: faults})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Injects scripted status codes with Cloudant errors`, func() {
+		faults.Script("GetDocument", cloudanttest.Fault{StatusCode: http.StatusServiceUnavailable}, cloudanttest.Fault{})
+
+		_, response, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(Equal("service_unavailable: Injected fault."))
+		Expect(response.GetStatusCode()).To(Equal(http.StatusServiceUnavailable))
+		Expect(response.GetResult()).To(HaveKey("errors"))
+
+		doc, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.ID).To(Equal("doc"))
+
+		_, _, err = service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(faults.Requests("GetDocument")).To(Equal(2))
+		Expect(faults.Injected("GetDocument")).To(Equal(1))
+		Expect(faults.Requests(cloudanttest.AnyOperation)).To(Equal(3))
+	})
+
+	It(`Succeeds after retries`, func() {
+		retryNow := http.Header{"Retry-After": []string{"0"}}
+		faults.Script("GetDocument",
+			cloudanttest.Fault{StatusCode: http.StatusTooManyRequests, Header: retryNow},
+			cloudanttest.Fault{StatusCode: http.StatusInternalServerError, Header: retryNow},
+		)
+		service.EnableRetries(2, 10*time.Millisecond)
+
+		doc, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.ID).To(Equal("doc"))
+		Expect(faults.Requests("GetDocument")).To(Equal(3))
+		Expect(faults.Injected("GetDocument")).To(Equal(2))
+	})
+
+	It(`Resets connections`, func() {
+		faults.Script(cloudanttest.AnyOperation, cloudanttest.Fault{Reset: true})
+
+		_, response, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(errors.Is(err, syscall.ECONNRESET)).To(BeTrue())
+		Expect(response).To(BeNil())
+	})
+
+	It(`Delays responses`, func() {
+		faults.Script("GetDocument", cloudanttest.Fault{Delay: time.Minute})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, _, err := service.GetDocumentWithContext(ctx, service.NewGetDocumentOptions("db", "doc"))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It(`Truncates streamed bodies`, func() {
+		faults.Script("PostChangesAsStream", cloudanttest.Fault{Truncate: 10})
+
+		stream, _, err := service.PostChangesAsStream(service.NewPostChangesOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		defer stream.Close()
+		body, err := io.ReadAll(stream)
+		Expect(err).To(MatchError(io.ErrUnexpectedEOF))
+		Expect(body).To(HaveLen(10))
+	})
+
+	It(`Injects repeatable random faults`, func() {
+		faults.Seed(42).Random("GetDocument", 0.5, cloudanttest.Fault{StatusCode: http.StatusBadGateway})
+		var failures []bool
+		for range 20 {
+			_, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+			failures = append(failures, err != nil)
+		}
+		Expect(faults.Injected("GetDocument")).To(BeNumerically(">", 0))
+		Expect(faults.Injected("GetDocument")).To(BeNumerically("<", 20))
+
+		repeated := cloudanttest.NewFaultInjector(nil)
+		service.Service.SetHTTPClient(&http.Client{Transport: repeated})
+		repeated.Seed(42).Random("GetDocument", 0.5, cloudanttest.Fault{StatusCode: http.StatusBadGateway})
+		for i := range 20 {
+			_, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+			Expect(err != nil).To(Equal(failures[i]))
+		}
+	})
+
+	Describe(`With a changes follower`, func() {
+		var options *cloudantv1.PostChangesOptions
+
+		BeforeEach(func() {
+			options = service.NewPostChangesOptions("db")
+			faults.Script("PostChanges",
+				cloudanttest.Fault{StatusCode: http.StatusTooManyRequests},
+				cloudanttest.Fault{StatusCode: http.StatusInternalServerError},
+			)
+		})
+
+		It(`Suppresses transient errors within the error tolerance`, func() {
+			follower, err := features.NewChangesFollower(service, options)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(follower.SetErrorTolerance(time.Minute)).To(Succeed())
+			changes, err := follower.StartOneOff()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changeIDs(changes, 1)).To(Equal([]string{"doc"}))
+			Expect(faults.Requests("PostChanges")).To(Equal(3))
+		})
+
+		It(`Returns transient errors without error tolerance`, func() {
+			follower, err := features.NewChangesFollower(service, options)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(follower.SetErrorTolerance(0)).To(Succeed())
+			changes, err := follower.StartOneOff()
+			Expect(err).ShouldNot(HaveOccurred())
+			var change features.ChangesItem
+			Eventually(changes, 5*time.Second).Should(Receive(&change))
+			_, err = change.Item()
+			Expect(err).To(MatchError("too_many_requests: Injected fault."))
+		})
+
+		It(`Stops on a connection reset`, func() {
+			faults.Script("PostChanges", cloudanttest.Fault{Reset: true})
+			follower, err := features.NewChangesFollower(service, options)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(follower.SetErrorTolerance(time.Minute)).To(Succeed())
+			changes, err := follower.StartOneOff()
+			Expect(err).ShouldNot(HaveOccurred())
+			var change features.ChangesItem
+			Eventually(changes, 5*time.Second).Should(Receive(&change))
+			_, err = change.Item()
+			Expect(errors.Is(err, syscall.ECONNRESET)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,133 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/mango"
+)
+
+// find handles the _find requests of a database or of a partition.
+// The documents are selected from all documents of the database,
+// indexes are not used.
+func (s *Server) find(r *request, db *database, partition string) *httpError {
+	if err := r.readParams(); err != nil {
+		return err
+	}
+	var selectorJSON map[string]interface{}
+	if err := json.Unmarshal(r.params["selector"], &selectorJSON); err != nil || selectorJSON == nil {
+		return &httpError{http.StatusBadRequest, "missing_required_key", "Missing required key: selector"}
+	}
+	selector, err := mango.Compile(selectorJSON)
+	if err != nil {
+		return &httpError{http.StatusBadRequest, "invalid_selector", err.Error()}
+	}
+	var sortSpec []interface{}
+	if _, ok := r.params["sort"]; ok {
+		if err := json.Unmarshal(r.params["sort"], &sortSpec); err != nil {
+			return &httpError{http.StatusBadRequest, "invalid_sort_json", "Sort must be an array of sort specs."}
+		}
+	}
+	sort, httpErr := compileSort(sortSpec)
+	if httpErr != nil {
+		return httpErr
+	}
+	start := r.params.int("skip", 0)
+	if bookmark, ok := r.params.string("bookmark"); ok && bookmark != "" && bookmark != "nil" {
+		b, decodeErr := base64.RawURLEncoding.DecodeString(bookmark)
+		if decodeErr != nil {
+			return &httpError{http.StatusBadRequest, "invalid_bookmark", "Invalid bookmark value: " + bookmark}
+		}
+		if start, decodeErr = strconv.ParseInt(string(b), 10, 64); decodeErr != nil {
+			return &httpError{http.StatusBadRequest, "invalid_bookmark", "Invalid bookmark value: " + bookmark}
+		}
+	}
+	limit := r.params.int("limit", 25)
+	fields := r.params.strings("fields")
+	o := docOptions{conflicts: r.params.bool("conflicts", false)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var docs []map[string]interface{}
+	examined := 0
+	for _, id := range db.docIDs() {
+		if strings.HasPrefix(id, "_design/") || (partition != "" && !strings.HasPrefix(id, partition+":")) {
+			continue
+		}
+		examined++
+		doc := db.docs[id]
+		body := documentBody(doc, doc.winner(), o)
+		if selector.Matches(body) && sort.HasFields(body) {
+			docs = append(docs, body)
+		}
+	}
+	slices.SortStableFunc(docs, sort.Compare)
+
+	start = min(max(start, 0), int64(len(docs)))
+	end := int64(len(docs))
+	if limit >= 0 {
+		end = min(end, start+limit)
+	}
+	page := make([]map[string]interface{}, 0, end-start)
+	for _, doc := range docs[start:end] {
+		page = append(page, mango.Project(doc, fields))
+	}
+	result := map[string]interface{}{
+		"docs":     page,
+		"bookmark": base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(end, 10))),
+	}
+	if r.params.bool("execution_stats", false) {
+		result["execution_stats"] = map[string]interface{}{
+			"total_keys_examined":        0,
+			"total_docs_examined":        examined,
+			"total_quorum_docs_examined": 0,
+			"results_returned":           len(page),
+			"execution_time_ms":          0.0,
+		}
+	}
+	return r.writeJSON(http.StatusOK, result)
+}
+
+// compileSort compiles a sort, where the sort specs are field names
+// sorted in ascending order or objects with a field and its direction.
+func compileSort(spec []interface{}) (*mango.Sort, *httpError) {
+	sort := make([]map[string]string, 0, len(spec))
+	for _, s := range spec {
+		switch s := s.(type) {
+		case string:
+			sort = append(sort, map[string]string{s: "asc"})
+		case map[string]interface{}:
+			for field, direction := range s {
+				d, _ := direction.(string)
+				sort = append(sort, map[string]string{field: d})
+			}
+		default:
+			return nil, &httpError{http.StatusBadRequest, "invalid_sort_field", "Invalid sort field"}
+		}
+	}
+	compiled, err := mango.CompileSort(sort)
+	if err != nil {
+		return nil, &httpError{http.StatusBadRequest, "invalid_sort", err.Error()}
+	}
+	return compiled, nil
+}
@@ -0,0 +1,112 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"fmt"
+
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/features"
+	"github.com/IBM/cloudant-go-sdk/selector"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fake server find tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+		for i := range 10 {
+			putDocument(service, "db", fmt.Sprintf("doc%d", i), newDocument(map[string]interface{}{
+				"count": i,
+				"name":  fmt.Sprintf("Name %d", i),
+				"tags":  []string{"all", []string{"even", "odd"}[i%2]},
+				"owner": map[string]interface{}{"id": i % 3},
+			}))
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Pages documents matching selectors`, func() {
+		s := selector.Field("count", selector.Gte(2)).MustBuild()
+		pagination := features.NewFindPagination(service, service.NewPostFindOptions("db", s).SetLimit(3))
+		Expect(pageSizes(pagination)).To(Equal([]int{3, 3, 2}))
+	})
+
+	It(`Evaluates the operators of selectors`, func() {
+		find := func(s selector.Selector) []string {
+			result, _, err := service.PostFind(service.NewPostFindOptions("db", s.MustBuild()))
+			Expect(err).ShouldNot(HaveOccurred())
+			ids := make([]string, 0)
+			for _, doc := range result.Docs {
+				ids = append(ids, *doc.ID)
+			}
+			return ids
+		}
+		Expect(find(selector.Field("tags", selector.ElemMatch(selector.Value(selector.Eq("odd")))))).
+			To(Equal([]string{"doc1", "doc3", "doc5", "doc7", "doc9"}))
+		Expect(find(selector.Field(selector.Path("owner", "id"), selector.Eq(0)))).
+			To(Equal([]string{"doc0", "doc3", "doc6", "doc9"}))
+		Expect(find(selector.Or(
+			selector.Field("count", selector.Lt(1)),
+			selector.Field("name", selector.Regex("^Name [89]$")),
+		))).To(Equal([]string{"doc0", "doc8", "doc9"}))
+		Expect(find(selector.And(
+			selector.Field("count", selector.Mod(4, 1)),
+			selector.Field("tags", selector.Size(2)),
+		))).To(Equal([]string{"doc1", "doc5", "doc9"}))
+		Expect(find(selector.Field("missing", selector.Exists(false)))).To(HaveLen(10))
+		Expect(find(selector.Field("missing", selector.Ne(1)))).To(BeEmpty())
+		Expect(find(selector.Not(selector.Field("count", selector.In(0, 1, 2, 3, 4, 5, 6, 7))))).
+			To(Equal([]string{"doc8", "doc9"}))
+	})
+
+	It(`Sorts and projects documents`, func() {
+		index, err := service.NewIndexDefinition([]cloudantv1.IndexField{})
+		Expect(err).ShouldNot(HaveOccurred())
+		field := cloudantv1.IndexField{}
+		field.SetProperty("count", core.StringPtr("asc"))
+		index.Fields = []cloudantv1.IndexField{field}
+		created, _, err := service.PostIndex(service.NewPostIndexOptions("db", index).SetName("by_count"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*created.Result).To(Equal("created"))
+
+		result, _, err := service.PostFind(service.NewPostFindOptions("db", selector.Field("count", selector.Gt(5)).MustBuild()).
+			SetSort([]map[string]string{{"count": "desc"}}).
+			SetFields([]string{"_id", "owner.id"}).
+			SetExecutionStats(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Docs).To(HaveLen(4))
+		Expect(*result.Docs[0].ID).To(Equal("doc9"))
+		Expect(result.Docs[0].GetProperty("owner")).To(Equal(map[string]interface{}{"id": float64(0)}))
+		Expect(result.Docs[0].GetProperty("count")).To(BeNil())
+		Expect(*result.ExecutionStats.ResultsReturned).To(BeEquivalentTo(4))
+
+		indexes, _, err := service.GetIndexesInformation(service.NewGetIndexesInformationOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(indexes.Indexes).To(HaveLen(2))
+		Expect(*indexes.Indexes[1].Name).To(Equal("by_count"))
+	})
+})
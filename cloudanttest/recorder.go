@@ -0,0 +1,518 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// Replay replays the recorded interactions and fails the
+	// requests without a recorded interaction.
+	Replay Mode = iota
+	// Record sends the requests to the transport and records the
+	// interactions, replacing the recorded interactions of the fixture.
+	Record
+	// ReplayOrRecord replays the recorded interactions and records
+	// the interactions of the requests without a recorded interaction.
+	ReplayOrRecord
+)
+
+// scrubbed replaces the values of credentials and cookies in fixtures.
+const scrubbed = "REDACTED"
+
+// operationHeader is the header with the operation ID of the requests of the service.
+const operationHeader = "X-IBMCloud-SDK-Analytics"
+
+// defaultScrubbed are the names of the headers, query parameters and
+// body fields scrubbed by a Recorder, in lower case.
+var defaultScrubbed = []string{
+	"authorization", "cookie", "set-cookie", "x-auth-token",
+	"password", "apikey", "access_token", "refresh_token", "token",
+}
+
+// ErrNoInteraction is wrapped by the error of a request without a
+// recorded interaction in Replay mode.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// RecorderOptions are the options of a Recorder.
+type RecorderOptions struct {
+	// Mode is the mode of the recorder, Replay by default.
+	Mode Mode
+	// Transport sends the requests in Record and ReplayOrRecord modes,
+	// http.DefaultTransport by default.
+	Transport http.RoundTripper
+	// Scrub are the names of extra headers, query parameters and
+	// JSON or form body fields to scrub, case insensitive.
+	Scrub []string
+}
+
+// Recorder is an http.RoundTripper recording request and response pairs
+// into a JSON fixture file and replaying them.
+//
+// The interactions are keyed by the operation ID of the request, its method,
+// path, query and normalized body, so fixtures recorded against an account
+// replay against any service URL. The same request replays its recorded
+// interactions in order, repeating the last one.
+//
+// Credentials and cookies are scrubbed from the recorded headers, query
+// parameters and request and JSON response bodies. Streamed response bodies, without a content length,
+// are recorded as they are read, so the streaming operations, for example
+// PostChangesAsStream, record the part of the body consumed by the client
+// before closing the body.
+//
+// Use it with the SetHTTPClient method of the service:
+//
+//	recorder, err := cloudanttest.NewRecorder("testdata/changes.json", cloudanttest.RecorderOptions{Mode: cloudanttest.ReplayOrRecord})
+//	defer recorder.Close()
+//	service.SetHTTPClient(&http.Client{Transport: recorder})
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrub     map[string]bool
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     map[string]int
+	changed      bool
+}
+
+// Fixture is the content of a fixture file.
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and response pair.
+type Interaction struct {
+	// Operation is the operation ID of the request, for example GetDocument.
+	Operation string `json:"operation,omitempty"`
+	// Method is the method of the request.
+	Method string `json:"method"`
+	// URL is the path and normalized query of the request.
+	URL string `json:"url"`
+	// RequestBody is the normalized body of the request.
+	RequestBody string `json:"request_body,omitempty"`
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"status_code"`
+	// Header is the header of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response.
+	Body string `json:"body,omitempty"`
+	// BodyEncoding is base64 for binary response bodies.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// NewRecorder returns a Recorder of a fixture file. The fixture is loaded
+// in Replay and ReplayOrRecord modes and must exist in Replay mode.
+func NewRecorder(path string, o RecorderOptions) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      o.Mode,
+		transport: o.Transport,
+		scrub:     make(map[string]bool),
+		replayed:  make(map[string]int),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	for _, name := range append(defaultScrubbed, o.Scrub...) {
+		r.scrub[strings.ToLower(name)] = true
+	}
+	if r.mode == Record {
+		return r, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && r.mode == ReplayOrRecord {
+		return r, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("loading fixture: %w", err)
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("loading fixture %s: %w", path, err)
+	}
+	r.interactions = fixture.Interactions
+	return r, nil
+}
+
+// Interactions returns the recorded interactions.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.interactions)
+}
+
+// Close saves the fixture file when interactions were recorded.
+// The response bodies must be closed before.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.changed {
+		return nil
+	}
+	data, err := json.MarshalIndent(Fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	r.changed = false
+	return nil
+}
+
+// RoundTrip implements RoundTripper interface
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := r.requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	key := &Interaction{
+		Operation:   operationID(req),
+		Method:      req.Method,
+		URL:         r.normalizeURL(req.URL),
+		RequestBody: r.normalizeBody(req.Header.Get("Content-Type"), body),
+	}
+
+	if r.mode != Record {
+		if interaction := r.replay(key); interaction != nil {
+			return interaction.response(req)
+		} else if r.mode == Replay {
+			return nil, fmt.Errorf("%w for %s %s %s", ErrNoInteraction, key.Operation, key.Method, key.URL)
+		}
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	key.StatusCode = resp.StatusCode
+	key.Header = r.scrubHeader(resp.Header)
+	if resp.ContentLength < 0 {
+		// a streamed body is recorded as it is read
+		r.record(key, nil)
+		resp.Body = &recordingBody{ReadCloser: resp.Body, recorder: r, interaction: key, contentType: resp.Header.Get("Content-Type")}
+		return resp, nil
+	}
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	r.record(key, r.scrubBody(resp.Header.Get("Content-Type"), body))
+	return resp, nil
+}
+
+// requestBody reads the body of a request and restores it.
+func (r *Recorder) requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(zr)
+	}
+	return data, nil
+}
+
+// replay returns the next recorded interaction of a request, or nil.
+func (r *Recorder) replay(key *Interaction) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matches []*Interaction
+	for _, interaction := range r.interactions {
+		if interaction.key() == key.key() {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	k := key.key()
+	i := min(r.replayed[k], len(matches)-1)
+	r.replayed[k] = i + 1
+	return matches[i]
+}
+
+// record adds an interaction with a response body.
+func (r *Recorder) record(interaction *Interaction, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	setBody(interaction, body)
+	r.interactions = append(r.interactions, interaction)
+	r.changed = true
+}
+
+// setBody sets the response body of an interaction.
+func setBody(interaction *Interaction, body []byte) {
+	if utf8.Valid(body) {
+		interaction.Body = string(body)
+		interaction.BodyEncoding = ""
+	} else {
+		interaction.Body = base64.StdEncoding.EncodeToString(body)
+		interaction.BodyEncoding = "base64"
+	}
+}
+
+// normalizeURL returns the path and the sorted and scrubbed query of a URL.
+func (r *Recorder) normalizeURL(u *url.URL) string {
+	query := u.Query()
+	for name := range query {
+		if r.scrub[strings.ToLower(name)] {
+			query[name] = []string{scrubbed}
+		}
+	}
+	if len(query) == 0 {
+		return u.EscapedPath()
+	}
+	return u.EscapedPath() + "?" + query.Encode()
+}
+
+// normalizeBody returns a JSON body with sorted keys or a sorted form body,
+// with the scrubbed fields replaced.
+func (r *Recorder) normalizeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for name := range form {
+				if r.scrub[strings.ToLower(name)] {
+					form[name] = []string{scrubbed}
+				}
+			}
+			return form.Encode()
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err == nil {
+		if normalized, err := json.Marshal(r.scrubValue(value)); err == nil {
+			return string(normalized)
+		}
+	}
+	return string(body)
+}
+
+// scrubBody returns a JSON body, or a body of JSON lines, with the scrubbed
+// fields replaced. A body without scrubbed fields is returned unchanged.
+func (r *Recorder) scrubBody(contentType string, body []byte) []byte {
+	if !strings.Contains(contentType, "json") {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var values []interface{}
+	var rest []byte
+	found := false
+	for {
+		offset := decoder.InputOffset()
+		var value interface{}
+		if err := decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			// the rest of a partially read body is not a complete value
+			rest = body[offset:]
+			break
+		}
+		found = found || r.hasScrubbed(value)
+		values = append(values, value)
+	}
+	if !found {
+		return body
+	}
+	var scrubbedBody bytes.Buffer
+	for _, value := range values {
+		data, _ := json.Marshal(r.scrubValue(value))
+		scrubbedBody.Write(data)
+		scrubbedBody.WriteByte('\n')
+	}
+	scrubbedBody.Write(rest)
+	return scrubbedBody.Bytes()
+}
+
+// hasScrubbed returns whether a JSON value has scrubbed fields.
+func (r *Recorder) hasScrubbed(value interface{}) bool {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, v := range value {
+			if r.scrub[strings.ToLower(name)] || r.hasScrubbed(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range value {
+			if r.hasScrubbed(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scrubValue replaces the scrubbed fields of a JSON value.
+func (r *Recorder) scrubValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, v := range value {
+			if r.scrub[strings.ToLower(name)] {
+				value[name] = scrubbed
+			} else {
+				value[name] = r.scrubValue(v)
+			}
+		}
+	case []interface{}:
+		for i, v := range value {
+			value[i] = r.scrubValue(v)
+		}
+	}
+	return value
+}
+
+// scrubHeader returns a copy of a header with the scrubbed values replaced.
+// The values of cookies are replaced and their attributes are kept.
+func (r *Recorder) scrubHeader(header http.Header) http.Header {
+	scrubbedHeader := header.Clone()
+	for name, values := range scrubbedHeader {
+		if !r.scrub[strings.ToLower(name)] {
+			continue
+		}
+		for i, value := range values {
+			if strings.EqualFold(name, "Set-Cookie") {
+				values[i] = scrubCookie(value)
+			} else {
+				values[i] = scrubbed
+			}
+		}
+	}
+	return scrubbedHeader
+}
+
+// scrubCookie replaces the value of a Set-Cookie header.
+func scrubCookie(value string) string {
+	cookie, attributes, _ := strings.Cut(value, ";")
+	name, _, ok := strings.Cut(cookie, "=")
+	if !ok {
+		return scrubbed
+	}
+	if attributes != "" {
+		return name + "=" + scrubbed + ";" + attributes
+	}
+	return name + "=" + scrubbed
+}
+
+// operationID returns the operation ID of the analytics header of a request.
+// The service sets the header without canonicalizing its name.
+func operationID(req *http.Request) string {
+	for name, values := range req.Header {
+		if !strings.EqualFold(name, operationHeader) || len(values) == 0 {
+			continue
+		}
+		for _, field := range strings.Split(values[0], ";") {
+			if id, ok := strings.CutPrefix(field, "operation_id="); ok {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// key returns the key of the request of an interaction.
+func (i *Interaction) key() string {
+	return strings.Join([]string{i.Operation, i.Method, i.URL, i.RequestBody}, "\n")
+}
+
+// response returns the recorded response to a request.
+func (i *Interaction) response(req *http.Request) (*http.Response, error) {
+	body := []byte(i.Body)
+	if i.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(i.Body); err != nil {
+			return nil, err
+		}
+	}
+	header := i.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// recordingBody sets the response body of a recorded interaction
+// to the part of the streamed body read when it is closed.
+type recordingBody struct {
+	io.ReadCloser
+	recorder    *Recorder
+	interaction *Interaction
+	contentType string
+	body        bytes.Buffer
+}
+
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.body.Write(p[:n])
+	return n, err
+}
+
+func (b *recordingBody) Close() error {
+	b.recorder.mu.Lock()
+	setBody(b.interaction, b.recorder.scrubBody(b.contentType, b.body.Bytes()))
+	b.recorder.mu.Unlock()
+	return b.ReadCloser.Close()
+}
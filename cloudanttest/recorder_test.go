@@ -0,0 +1,250 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/cloudant-go-sdk/auth"
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// unreachableURL is the URL of the services replaying fixtures.
+const unreachableURL = "http://127.0.0.1:1"
+
+// newRecordingService returns a service of a URL using a recorder.
+func newRecordingService(url string, authenticator core.Authenticator, recorder *cloudanttest.Recorder) *cloudantv1.CloudantV1 {
+	service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+		URL:           url,
+		Authenticator: authenticator,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	service.Service.SetHTTPClient(&http.Client{Transport: recorder})
+	return service
+}
+
+var _ = Describe(`Recorder tests`, func() {
+	var server *cloudanttest.Server
+	var dir, fixture string
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		var err error
+		dir, err = os.MkdirTemp("", "recorder")
+		Expect(err).ShouldNot(HaveOccurred())
+		fixture = filepath.Join(dir, "testdata", "fixture.json")
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Records and replays operations`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.Record})
+		Expect(err).ShouldNot(HaveOccurred())
+		service := newRecordingService(server.URL, &core.NoAuthAuthenticator{}, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		rev := putDocument(service, "db", "doc", newDocument(map[string]interface{}{"count": 1}))
+		putDocument(service, "db", "doc", newDocument(map[string]interface{}{"_rev": rev, "count": 2}))
+		recorded, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+		Expect(recorder.Interactions()).To(HaveLen(4))
+		Expect(recorder.Interactions()[3].Operation).To(Equal("GetDocument"))
+		Expect(recorder.Interactions()[3].URL).To(Equal("/db/doc"))
+
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		service = newRecordingService(unreachableURL, &core.NoAuthAuthenticator{}, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		putDocument(service, "db", "doc", newDocument(map[string]interface{}{"count": 1}))
+		replayed, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(replayed).To(Equal(recorded))
+		Expect(replayed.GetProperty("count")).To(BeEquivalentTo(2))
+	})
+
+	It(`Replays the interactions of a request in order`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.Record})
+		Expect(err).ShouldNot(HaveOccurred())
+		service := newRecordingService(server.URL, &core.NoAuthAuthenticator{}, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		for i := range 2 {
+			putDocument(service, "db", "doc"+string(rune('a'+i)), newDocument(nil))
+			_, _, err = service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(recorder.Close()).To(Succeed())
+
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		service = newRecordingService(unreachableURL, &core.NoAuthAuthenticator{}, recorder)
+		var counts []int64
+		for range 3 {
+			info, _, err := service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+			Expect(err).ShouldNot(HaveOccurred())
+			counts = append(counts, *info.DocCount)
+		}
+		Expect(counts).To(Equal([]int64{1, 2, 2}))
+	})
+
+	It(`Fails requests without a recorded interaction`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.ReplayOrRecord})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+		_, err = os.Stat(fixture)
+		Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+
+		_, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+
+		Expect(os.MkdirAll(filepath.Dir(fixture), 0755)).To(Succeed())
+		Expect(os.WriteFile(fixture, []byte(`{"interactions": []}`), 0644)).To(Succeed())
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		client := &http.Client{Transport: recorder}
+		_, err = client.Get(unreachableURL + "/db")
+		Expect(errors.Is(err, cloudanttest.ErrNoInteraction)).To(BeTrue())
+	})
+
+	It(`Matches normalized bodies`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.ReplayOrRecord})
+		Expect(err).ShouldNot(HaveOccurred())
+		client := &http.Client{Transport: recorder}
+		service := newService(server, "db")
+		putDocument(service, "db", "doc", newDocument(map[string]interface{}{"type": "a"}))
+
+		body := `{"selector": {"type": "a"}, "fields": ["_id"]}`
+		resp, err := client.Post(server.URL+"/db/_find", "application/json", bytes.NewBufferString(body))
+		Expect(err).ShouldNot(HaveOccurred())
+		recorded, err := io.ReadAll(resp.Body)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(recorder.Interactions()).To(HaveLen(1))
+		Expect(recorder.Interactions()[0].RequestBody).To(Equal(`{"fields":["_id"],"selector":{"type":"a"}}`))
+
+		server.Close()
+		body = `{"fields":["_id"],"selector":{"type":"a"}}`
+		resp, err = client.Post(unreachableURL+"/db/_find", "application/json", bytes.NewBufferString(body))
+		Expect(err).ShouldNot(HaveOccurred())
+		replayed, err := io.ReadAll(resp.Body)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(replayed).To(Equal(recorded))
+		Expect(recorder.Interactions()).To(HaveLen(1))
+	})
+
+	It(`Scrubs credentials and cookies`, func() {
+		server.AddUser("user", "secret")
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.Record})
+		Expect(err).ShouldNot(HaveOccurred())
+		authenticator, err := auth.NewCouchDbSessionAuthenticator("user", "secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		service := newRecordingService(server.URL, authenticator, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(recorder.Close()).To(Succeed())
+
+		data, err := os.ReadFile(fixture)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("secret"))
+		Expect(string(data)).To(ContainSubstring("AuthSession=REDACTED"))
+
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		authenticator, err = auth.NewCouchDbSessionAuthenticator("user", "secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		service = newRecordingService(unreachableURL, authenticator, recorder)
+		result, _, err := service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*result.Ok).To(BeTrue())
+	})
+
+	It(`Scrubs response bodies`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.Record})
+		Expect(err).ShouldNot(HaveOccurred())
+		service := newRecordingService(server.URL, &core.NoAuthAuthenticator{}, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		putDocument(service, "db", "doc", newDocument(map[string]interface{}{"password": "secret"}))
+		document, _, err := service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(document.GetProperty("password")).To(Equal("secret"))
+		stream, _, err := service.PostChangesAsStream(service.NewPostChangesOptions("db").SetIncludeDocs(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		recorded, err := io.ReadAll(stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.Close()).To(Succeed())
+		Expect(string(recorded)).To(ContainSubstring("secret"))
+		Expect(recorder.Close()).To(Succeed())
+
+		data, err := os.ReadFile(fixture)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("secret"))
+
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		service = newRecordingService(unreachableURL, &core.NoAuthAuthenticator{}, recorder)
+		document, _, err = service.GetDocument(service.NewGetDocumentOptions("db", "doc"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(document.GetProperty("password")).To(Equal("REDACTED"))
+		stream, _, err = service.PostChangesAsStream(service.NewPostChangesOptions("db").SetIncludeDocs(true))
+		Expect(err).ShouldNot(HaveOccurred())
+		replayed, err := io.ReadAll(stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.Close()).To(Succeed())
+		Expect(string(replayed)).To(ContainSubstring(`"password":"REDACTED"`))
+	})
+
+	It(`Records streaming operations`, func() {
+		recorder, err := cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{Mode: cloudanttest.Record})
+		Expect(err).ShouldNot(HaveOccurred())
+		service := newRecordingService(server.URL, &core.NoAuthAuthenticator{}, recorder)
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		putDocument(service, "db", "doc", newDocument(nil))
+		stream, _, err := service.PostChangesAsStream(service.NewPostChangesOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		recorded, err := io.ReadAll(stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.Close()).To(Succeed())
+		Expect(recorder.Close()).To(Succeed())
+
+		recorder, err = cloudanttest.NewRecorder(fixture, cloudanttest.RecorderOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		service = newRecordingService(unreachableURL, &core.NoAuthAuthenticator{}, recorder)
+		stream, _, err = service.PostChangesAsStream(service.NewPostChangesOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		replayed, err := io.ReadAll(stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.Close()).To(Succeed())
+		Expect(replayed).To(Equal(recorded))
+		Expect(string(replayed)).To(ContainSubstring(`"id":"doc"`))
+	})
+})
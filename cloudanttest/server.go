@@ -0,0 +1,476 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cloudanttest provides an in-memory fake Cloudant server for unit tests.
+//
+// The server implements the core document, bulk, _all_docs, _find, _changes,
+// _local, attachment and _session endpoints with the revision and conflict
+// semantics of CouchDB, so a service created with cloudantv1.NewCloudantV1
+// and the URL of the server can run realistic tests, including the
+// ChangesFollower and the pagers of the features package.
+//
+// Views are run with Go map functions registered with AddView
+// because the server cannot run the JavaScript of design documents.
+// The search (_search, _search_info and _search_analyze), replication
+// (_replicate and the _replicator database), scheduler (_scheduler/docs and
+// _scheduler/jobs) and other endpoints are not implemented and respond with
+// a 501 status code and a not_implemented error, so the search and scheduler
+// pagers of the features package cannot run against the server.
+//
+// The package also provides a Recorder transport recording the requests
+// and responses of a service into fixture files and replaying them, and a
+// FaultInjector transport injecting error responses, connection resets,
+// delays and truncated bodies.
+package cloudanttest
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Server is an in-memory fake Cloudant server.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	dbs      map[string]*database
+	users    map[string]string
+	sessions map[string]string
+	views    map[string]View
+	filters  map[string]Filter
+
+	// changed is closed and replaced on each change of a database.
+	changed chan struct{}
+
+	// closed is closed when the server is closed.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// View is a view run by the server with a Go map function.
+type View struct {
+	// Map is called for each document with the properties of the document,
+	// including _id and _rev, and emits the rows of the document.
+	Map func(doc map[string]interface{}, emit func(key, value interface{}))
+
+	// Reduce is an optional built-in reduce function, one of _count, _sum and _stats.
+	Reduce string
+}
+
+// Filter is a changes feed filter function run by the server. The params are
+// the query parameters of the changes request.
+type Filter func(doc map[string]interface{}, params url.Values) bool
+
+// NewServer starts and returns a new fake server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		dbs:      make(map[string]*database),
+		users:    make(map[string]string),
+		sessions: make(map[string]string),
+		views:    make(map[string]View),
+		filters:  make(map[string]Filter),
+		changed:  make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts down the server, ending the pending longpoll
+// and continuous changes requests, and blocks until all
+// outstanding requests on this server have completed.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	s.Server.Close()
+}
+
+// AddUser adds a user to the server. Once the server has a user,
+// requests must be authenticated with basic authentication
+// or with a session cookie from the _session endpoint.
+func (s *Server) AddUser(name, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[name] = password
+}
+
+// AddView adds the view of the design document ddoc of the database db.
+// The ddoc is the name of the design document without the _design/ prefix.
+func (s *Server) AddView(db, ddoc, view string, v View) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.views[designPath(db, ddoc, view)] = v
+}
+
+// AddFilter adds the changes feed filter function of the design document ddoc
+// of the database db, used with the filter ddoc/filter.
+func (s *Server) AddFilter(db, ddoc, filter string, f Filter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filters[designPath(db, ddoc, filter)] = f
+}
+
+func designPath(db, ddoc, name string) string {
+	return db + "/" + strings.TrimPrefix(ddoc, "_design/") + "/" + name
+}
+
+// notify wakes up the requests waiting for changes.
+// It must be called with the lock held.
+func (s *Server) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// httpError is an error response of the server.
+type httpError struct {
+	status int
+	err    string
+	reason string
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.status, e.err, e.reason)
+}
+
+var (
+	errConflict     = &httpError{http.StatusConflict, "conflict", "Document update conflict."}
+	errMissing      = &httpError{http.StatusNotFound, "not_found", "missing"}
+	errDeleted      = &httpError{http.StatusNotFound, "not_found", "deleted"}
+	errNoDatabase   = &httpError{http.StatusNotFound, "not_found", "Database does not exist."}
+	errUnauthorized = &httpError{http.StatusUnauthorized, "unauthorized", "Name or password is incorrect."}
+)
+
+func badRequest(format string, a ...interface{}) *httpError {
+	return &httpError{http.StatusBadRequest, "bad_request", fmt.Sprintf(format, a...)}
+}
+
+// notImplemented is the error of the endpoints the server does not implement,
+// for example _search, _scheduler and _replicate.
+func notImplemented(endpoint string) *httpError {
+	return &httpError{http.StatusNotImplemented, "not_implemented", "The fake server does not implement " + endpoint}
+}
+
+func methodNotAllowed(allowed string) *httpError {
+	return &httpError{http.StatusMethodNotAllowed, "method_not_allowed", "Only " + allowed + " allowed"}
+}
+
+// request is a request to the server with its path split into unescaped segments.
+type request struct {
+	*http.Request
+	w      http.ResponseWriter
+	path   []string
+	params params
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path, err := splitPath(r.URL.EscapedPath())
+	if err != nil {
+		writeError(w, badRequest("Invalid path: %v", err))
+		return
+	}
+	req := &request{Request: r, w: w, path: path, params: queryParams(r.URL.Query())}
+	if err := s.authenticate(req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := s.route(req); err != nil {
+		writeError(w, err)
+	}
+}
+
+// splitPath splits an escaped path into unescaped segments, joining
+// _design and _local with the next segment into the document ID.
+func splitPath(escaped string) ([]string, error) {
+	var path []string
+	for _, segment := range strings.Split(strings.Trim(escaped, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, unescaped)
+	}
+	for i := 1; i < len(path)-1; i++ {
+		if path[i] == "_design" || path[i] == "_local" {
+			path[i] += "/" + path[i+1]
+			path = append(path[:i+1], path[i+2:]...)
+			break
+		}
+	}
+	return path, nil
+}
+
+func (s *Server) route(r *request) *httpError {
+	if len(r.path) == 0 {
+		return r.get(func() *httpError { return r.writeJSON(http.StatusOK, serverInformation) })
+	}
+	switch r.path[0] {
+	case "_up":
+		return r.get(func() *httpError { return r.writeJSON(http.StatusOK, map[string]interface{}{"status": "ok"}) })
+	case "_session":
+		return s.session(r)
+	case "_all_dbs":
+		return r.get(func() *httpError { return s.allDbs(r) })
+	case "_dbs_info":
+		return r.post(func() *httpError { return s.dbsInfo(r) })
+	}
+	if strings.HasPrefix(r.path[0], "_") {
+		return notImplemented(r.path[0])
+	}
+	if len(r.path) == 1 {
+		return s.database(r)
+	}
+
+	s.mu.Lock()
+	db := s.dbs[r.path[0]]
+	s.mu.Unlock()
+	if db == nil {
+		return errNoDatabase
+	}
+	switch r.path[1] {
+	case "_all_docs", "_design_docs":
+		return s.allDocs(r, db, "")
+	case "_bulk_docs":
+		return r.post(func() *httpError { return s.bulkDocs(r, db) })
+	case "_bulk_get":
+		return r.post(func() *httpError { return s.bulkGet(r, db) })
+	case "_find":
+		return r.post(func() *httpError { return s.find(r, db, "") })
+	case "_index":
+		return s.index(r, db)
+	case "_changes":
+		return s.changes(r, db)
+	case "_partition":
+		return s.partition(r, db)
+	}
+	if strings.HasPrefix(r.path[1], "_local/") {
+		return s.localDocument(r, db)
+	}
+	if strings.HasPrefix(r.path[1], "_") && !strings.HasPrefix(r.path[1], "_design/") {
+		return notImplemented(r.path[1])
+	}
+	if len(r.path) > 2 && strings.HasPrefix(r.path[1], "_design/") && strings.HasPrefix(r.path[2], "_") {
+		if r.path[2] == "_view" && len(r.path) > 3 {
+			return s.view(r, db, strings.TrimPrefix(r.path[1], "_design/"), r.path[3], "")
+		}
+		return notImplemented(r.path[2])
+	}
+	if len(r.path) > 2 {
+		return s.attachment(r, db)
+	}
+	return s.document(r, db)
+}
+
+// partition handles the requests of a partition of a database.
+func (s *Server) partition(r *request, db *database) *httpError {
+	if !db.partitioned {
+		return badRequest("database is not partitioned")
+	}
+	if len(r.path) < 3 {
+		return errMissing
+	}
+	partition := r.path[2]
+	if len(r.path) == 3 {
+		return r.get(func() *httpError { return s.partitionInformation(r, db, partition) })
+	}
+	switch r.path[3] {
+	case "_all_docs":
+		return s.allDocs(r, db, partition)
+	case "_find":
+		return r.post(func() *httpError { return s.find(r, db, partition) })
+	}
+	if len(r.path) > 5 && strings.HasPrefix(r.path[3], "_design/") && r.path[4] == "_view" {
+		return s.view(r, db, strings.TrimPrefix(r.path[3], "_design/"), r.path[5], partition)
+	}
+	if len(r.path) > 4 && strings.HasPrefix(r.path[3], "_design/") {
+		return notImplemented(r.path[4])
+	}
+	return notImplemented(r.path[3])
+}
+
+// get calls handle for GET and HEAD requests.
+func (r *request) get(handle func() *httpError) *httpError {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return methodNotAllowed("GET,HEAD")
+	}
+	return handle()
+}
+
+// post calls handle for POST requests.
+func (r *request) post(handle func() *httpError) *httpError {
+	if r.Method != http.MethodPost {
+		return methodNotAllowed("POST")
+	}
+	return handle()
+}
+
+// params are the parameters of a request, from the query string
+// and from the JSON object of the body of POST query requests.
+type params map[string]json.RawMessage
+
+// queryParams returns the params of the query string.
+// Query values that are not valid JSON are kept as strings.
+func queryParams(query url.Values) params {
+	p := make(params)
+	for name, values := range query {
+		value := values[len(values)-1]
+		if json.Valid([]byte(value)) {
+			p[name] = json.RawMessage(value)
+		} else {
+			p[name], _ = json.Marshal(value)
+		}
+	}
+	return p
+}
+
+// readParams adds the properties of the JSON body of a POST request to the params.
+func (r *request) readParams() *httpError {
+	if r.Method != http.MethodPost {
+		return nil
+	}
+	body, err := r.readBody()
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if jsonErr := json.Unmarshal(body, &fields); jsonErr != nil {
+		return badRequest("invalid UTF-8 JSON")
+	}
+	for name, value := range fields {
+		r.params[name] = value
+	}
+	return nil
+}
+
+// readBody reads the body of the request, decompressing gzip content.
+func (r *request) readBody() ([]byte, *httpError) {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, badRequest("Invalid gzip body: %v", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, badRequest("Invalid body: %v", err)
+	}
+	return body, nil
+}
+
+// readJSON reads the JSON body of the request into v.
+func (r *request) readJSON(v interface{}) *httpError {
+	body, err := r.readBody()
+	if err != nil {
+		return err
+	}
+	if jsonErr := json.Unmarshal(body, v); jsonErr != nil {
+		return badRequest("invalid UTF-8 JSON")
+	}
+	return nil
+}
+
+func (p params) bool(name string, value bool) bool {
+	_ = json.Unmarshal(p[name], &value)
+	return value
+}
+
+func (p params) int(name string, value int64) int64 {
+	_ = json.Unmarshal(p[name], &value)
+	return value
+}
+
+func (p params) string(name string) (string, bool) {
+	var value string
+	if err := json.Unmarshal(p[name], &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func (p params) strings(name string) []string {
+	var value []string
+	_ = json.Unmarshal(p[name], &value)
+	return value
+}
+
+// key returns the view key param with objects as base.OrderedObject.
+func (p params) key(name string) (interface{}, bool) {
+	if _, ok := p[name]; !ok {
+		return nil, false
+	}
+	key, err := decodeKey(p[name])
+	return key, err == nil
+}
+
+// keys returns the keys param with objects as base.OrderedObject.
+func (p params) keys(name string) ([]interface{}, bool) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(p[name], &raw); err != nil {
+		return nil, false
+	}
+	keys := make([]interface{}, 0, len(raw))
+	for _, r := range raw {
+		key, err := decodeKey(r)
+		if err != nil {
+			return nil, false
+		}
+		keys = append(keys, key)
+	}
+	return keys, true
+}
+
+func (r *request) writeJSON(status int, v interface{}) *httpError {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return &httpError{http.StatusInternalServerError, "error", err.Error()}
+	}
+	r.w.Header().Set("Content-Type", "application/json")
+	r.w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_, _ = r.w.Write(b)
+	}
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err *httpError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.err, "reason": err.reason})
+}
+
+var serverInformation = map[string]interface{}{
+	"couchdb":  "Welcome",
+	"version":  "3.3.3",
+	"vendor":   map[string]interface{}{"name": "IBM Cloudant", "variant": "paas", "version": "8521"},
+	"features": []string{"access-ready", "partitioned", "pluggable-storage-engines", "reshard", "scheduler"},
+}
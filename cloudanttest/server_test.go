@@ -0,0 +1,84 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"net/http"
+
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fake server tests`, func() {
+	var server *cloudanttest.Server
+	var service *cloudantv1.CloudantV1
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		service = newService(server, "db")
+		_, _, err := service.PutDatabase(service.NewPutDatabaseOptions("pdb").SetPartitioned(true))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Responds not implemented to the search, scheduler and replication endpoints`, func() {
+		for _, c := range []struct {
+			endpoint string
+			call     func() (*core.DetailedResponse, error)
+		}{
+			{"_search", func() (*core.DetailedResponse, error) {
+				_, response, err := service.PostSearch(service.NewPostSearchOptions("db", "ddoc", "index", "*:*"))
+				return response, err
+			}},
+			{"_search", func() (*core.DetailedResponse, error) {
+				_, response, err := service.PostPartitionSearch(service.NewPostPartitionSearchOptions("pdb", "p", "ddoc", "index", "*:*"))
+				return response, err
+			}},
+			{"_search_info", func() (*core.DetailedResponse, error) {
+				_, response, err := service.GetSearchInfo(service.NewGetSearchInfoOptions("db", "ddoc", "index"))
+				return response, err
+			}},
+			{"_search_analyze", func() (*core.DetailedResponse, error) {
+				_, response, err := service.PostSearchAnalyze(service.NewPostSearchAnalyzeOptions("standard", "text"))
+				return response, err
+			}},
+			{"_scheduler", func() (*core.DetailedResponse, error) {
+				_, response, err := service.GetSchedulerDocs(service.NewGetSchedulerDocsOptions())
+				return response, err
+			}},
+			{"_scheduler", func() (*core.DetailedResponse, error) {
+				_, response, err := service.GetSchedulerJobs(service.NewGetSchedulerJobsOptions())
+				return response, err
+			}},
+			{"_replicator", func() (*core.DetailedResponse, error) {
+				_, response, err := service.GetReplicationDocument(service.NewGetReplicationDocumentOptions("rep"))
+				return response, err
+			}},
+		} {
+			response, err := c.call()
+			Expect(err).Should(HaveOccurred(), c.endpoint)
+			Expect(response.StatusCode).To(Equal(http.StatusNotImplemented), c.endpoint)
+			Expect(err.Error()).To(Equal("not_implemented: The fake server does not implement "+c.endpoint), c.endpoint)
+		}
+	})
+})
@@ -0,0 +1,146 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// sessionCookieName is the name of the cookie of a session.
+const sessionCookieName = "AuthSession"
+
+// sessionLifetime is the lifetime of the cookie of a session.
+const sessionLifetime = 24 * time.Hour
+
+// authenticate checks the credentials of a request when the server has users.
+// The _session and _up endpoints don't need credentials.
+func (s *Server) authenticate(r *request) *httpError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.users) == 0 {
+		return nil
+	}
+	if len(r.path) > 0 && (r.path[0] == "_session" || r.path[0] == "_up") {
+		return nil
+	}
+	if s.user(r) == "" {
+		return errUnauthorized
+	}
+	return nil
+}
+
+// user returns the name of the authenticated user of a request, or "".
+// It must be called with the lock held.
+func (s *Server) user(r *request) string {
+	if name, password, ok := r.BasicAuth(); ok {
+		if p, exists := s.users[name]; exists && p == password {
+			return name
+		}
+		return ""
+	}
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		return s.sessions[cookie.Value]
+	}
+	return ""
+}
+
+// session handles the requests of the _session endpoint.
+func (s *Server) session(r *request) *httpError {
+	switch r.Method {
+	case http.MethodPost:
+		name, password, err := r.credentials()
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		p, exists := s.users[name]
+		if !exists || p != password {
+			s.mu.Unlock()
+			return errUnauthorized
+		}
+		token := newToken()
+		s.sessions[token] = name
+		s.mu.Unlock()
+		http.SetCookie(r.w, &http.Cookie{
+			Name:     sessionCookieName,
+			Value:    token,
+			Path:     "/",
+			Expires:  time.Now().Add(sessionLifetime),
+			HttpOnly: true,
+		})
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true, "name": name, "roles": []string{}})
+	case http.MethodGet:
+		s.mu.Lock()
+		name := s.user(r)
+		s.mu.Unlock()
+		authenticated := "cookie"
+		if _, _, ok := r.BasicAuth(); ok {
+			authenticated = "default"
+		}
+		userCtx := map[string]interface{}{"name": nil, "roles": []string{}}
+		info := map[string]interface{}{"authentication_handlers": []string{"cookie", "default"}}
+		if name != "" {
+			userCtx["name"] = name
+			info["authenticated"] = authenticated
+		}
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true, "userCtx": userCtx, "info": info})
+	case http.MethodDelete:
+		if cookie, err := r.Cookie(sessionCookieName); err == nil {
+			s.mu.Lock()
+			delete(s.sessions, cookie.Value)
+			s.mu.Unlock()
+		}
+		http.SetCookie(r.w, &http.Cookie{Name: sessionCookieName, Value: "", Path: "/", MaxAge: -1})
+		return r.writeJSON(http.StatusOK, map[string]interface{}{"ok": true})
+	}
+	return methodNotAllowed("DELETE,GET,POST")
+}
+
+// credentials returns the name and password of a session request,
+// from a form or JSON body, or from basic authentication.
+func (r *request) credentials() (string, string, *httpError) {
+	switch {
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "application/json"):
+		var body struct {
+			Name     string `json:"name"`
+			Password string `json:"password"`
+		}
+		if err := r.readJSON(&body); err != nil {
+			return "", "", err
+		}
+		return body.Name, body.Password, nil
+	case strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"):
+		if err := r.ParseForm(); err != nil {
+			return "", "", badRequest("Invalid form: %v", err)
+		}
+		return r.PostForm.Get("name"), r.PostForm.Get("password"), nil
+	}
+	if name, password, ok := r.BasicAuth(); ok {
+		return name, password, nil
+	}
+	return "", "", badRequest("Missing name and password")
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,91 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudanttest_test
+
+import (
+	"net/http"
+
+	"github.com/IBM/cloudant-go-sdk/auth"
+	"github.com/IBM/cloudant-go-sdk/cloudanttest"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fake server session tests`, func() {
+	var server *cloudanttest.Server
+
+	BeforeEach(func() {
+		server = cloudanttest.NewServer()
+		server.AddUser("user", "pass")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Authenticates requests with session cookies`, func() {
+		authenticator, err := auth.NewCouchDbSessionAuthenticator("user", "pass")
+		Expect(err).ShouldNot(HaveOccurred())
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: authenticator,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, _, err = service.PutDatabase(service.NewPutDatabaseOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		session, _, err := service.GetSessionInformation(service.NewGetSessionInformationOptions())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*session.Ok).To(BeTrue())
+		Expect(*session.UserCtx.Name).To(Equal("user"))
+		Expect(*session.Info.Authenticated).To(Equal("cookie"))
+	})
+
+	It(`Authenticates requests with basic authentication`, func() {
+		authenticator, err := core.NewBasicAuthenticator("user", "pass")
+		Expect(err).ShouldNot(HaveOccurred())
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: authenticator,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, _, err = service.GetAllDbs(service.NewGetAllDbsOptions())
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It(`Rejects requests without valid credentials`, func() {
+		service := newService(server, "")
+		_, response, err := service.GetAllDbs(service.NewGetAllDbsOptions())
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		authenticator, err := auth.NewCouchDbSessionAuthenticator("user", "wrong")
+		Expect(err).ShouldNot(HaveOccurred())
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: authenticator,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = service.GetAllDbs(service.NewGetAllDbsOptions())
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Name or password is incorrect."))
+	})
+})
@@ -0,0 +1,105 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudantv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Decoding of legacy responses`, func() {
+	var (
+		testServer *httptest.Server
+		service    *cloudantv1.CloudantV1
+		body       string
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Decodes search analyzers in the string form`, func() {
+		body = `{"_id": "_design/ddoc", "indexes": {
+			"a": {"index": "function(doc){}", "analyzer": "keyword"},
+			"b": {"index": "function(doc){}", "analyzer": {"name": "perfield", "default": "english", "fields": {"es": "spanish"}}}
+		}}`
+		ddoc, _, err := service.GetDesignDocument(service.NewGetDesignDocumentOptions("db", "ddoc"))
+		Expect(err).To(BeNil())
+		Expect(*ddoc.Indexes["a"].Analyzer.Name).To(Equal("keyword"))
+		Expect(*ddoc.Indexes["b"].Analyzer.Default.Name).To(Equal("english"))
+		Expect(*ddoc.Indexes["b"].Analyzer.Fields["es"].Name).To(Equal("spanish"))
+	})
+
+	It(`Decodes index analyzers in the string form`, func() {
+		body = `{"total_rows": 1, "indexes": [{"ddoc": "_design/idx", "name": "text", "type": "text",
+			"def": {"fields": [], "default_analyzer": "keyword", "default_field": {"analyzer": "standard", "enabled": true}}}]}`
+		indexes, _, err := service.GetIndexesInformation(service.NewGetIndexesInformationOptions("db"))
+		Expect(err).To(BeNil())
+		Expect(*indexes.Indexes[0].Def.DefaultAnalyzer.Name).To(Equal("keyword"))
+		Expect(*indexes.Indexes[0].Def.DefaultField.Analyzer.Name).To(Equal("standard"))
+	})
+
+	It(`Decodes faceted search results without matches`, func() {
+		body = `{"total_rows": 0, "bookmark": "g2o", "rows": [], "counts": {"type": []}}`
+		result, _, err := service.PostSearch(service.NewPostSearchOptions("db", "ddoc", "index", "*:*"))
+		Expect(err).To(BeNil())
+		Expect(result.Counts).To(HaveKeyWithValue("type", BeEmpty()))
+	})
+
+	It(`Decodes malformed responses leniently`, func() {
+		body = `{"db_name": "db", "doc_count": "many", "unknown": true, "sizes": {"active": 1, "external": "2"}}`
+		_, _, err := service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+		Expect(err).ToNot(BeNil())
+
+		issues := make([]base.DecodingIssue, 0)
+		service.Service.EnableLenientDecoding(func(issue base.DecodingIssue) {
+			issues = append(issues, issue)
+		})
+		info, _, err := service.GetDatabaseInformation(service.NewGetDatabaseInformationOptions("db"))
+		Expect(err).To(BeNil())
+		Expect(*info.DbName).To(Equal("db"))
+		Expect(info.DocCount).To(BeNil())
+		Expect(*info.Sizes.Active).To(BeEquivalentTo(1))
+		Expect(info.Sizes.External).To(BeNil())
+		paths := make([]string, 0)
+		for _, issue := range issues {
+			paths = append(paths, issue.Path)
+		}
+		Expect(paths).To(ConsistOf("doc_count", "sizes.external", "unknown"))
+	})
+})
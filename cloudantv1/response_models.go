@@ -0,0 +1,102 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudantv1
+
+import (
+	"github.com/IBM/cloudant-go-sdk/base"
+)
+
+// responseModels are the models of the JSON responses of the operations
+// for lenient decoding and the legacy forms of models in the base service.
+var responseModels = map[string]interface{}{
+	"GetServerInformation":               (*ServerInformation)(nil),
+	"GetCapacityThroughputInformation":   (*CapacityThroughputInformation)(nil),
+	"PutCapacityThroughputConfiguration": (*CapacityThroughputInformation)(nil),
+	"GetUuids":                           (*UuidsResult)(nil),
+	"GetDbUpdates":                       (*DbUpdates)(nil),
+	"PostChanges":                        (*ChangesResult)(nil),
+	"PostDbsInfo":                        []DbsInfoResult(nil),
+	"DeleteDatabase":                     (*Ok)(nil),
+	"GetDatabaseInformation":             (*DatabaseInformation)(nil),
+	"PutDatabase":                        (*Ok)(nil),
+	"PostDocument":                       (*DocumentResult)(nil),
+	"PostAllDocs":                        (*AllDocsResult)(nil),
+	"PostAllDocsQueries":                 (*AllDocsQueriesResult)(nil),
+	"PostBulkDocs":                       []DocumentResult(nil),
+	"PostBulkGet":                        (*BulkGetResult)(nil),
+	"DeleteDocument":                     (*DocumentResult)(nil),
+	"GetDocument":                        (*Document)(nil),
+	"PutDocument":                        (*DocumentResult)(nil),
+	"DeleteDesignDocument":               (*DocumentResult)(nil),
+	"GetDesignDocument":                  (*DesignDocument)(nil),
+	"PutDesignDocument":                  (*DocumentResult)(nil),
+	"GetDesignDocumentInformation":       (*DesignDocumentInformation)(nil),
+	"PostDesignDocs":                     (*AllDocsResult)(nil),
+	"PostDesignDocsQueries":              (*AllDocsQueriesResult)(nil),
+	"PostView":                           (*ViewResult)(nil),
+	"PostViewQueries":                    (*ViewQueriesResult)(nil),
+	"GetPartitionInformation":            (*PartitionInformation)(nil),
+	"PostPartitionAllDocs":               (*AllDocsResult)(nil),
+	"PostPartitionSearch":                (*SearchResult)(nil),
+	"PostPartitionView":                  (*ViewResult)(nil),
+	"PostPartitionExplain":               (*ExplainResult)(nil),
+	"PostPartitionFind":                  (*FindResult)(nil),
+	"PostExplain":                        (*ExplainResult)(nil),
+	"PostFind":                           (*FindResult)(nil),
+	"GetIndexesInformation":              (*IndexesInformation)(nil),
+	"PostIndex":                          (*IndexResult)(nil),
+	"DeleteIndex":                        (*Ok)(nil),
+	"PostSearchAnalyze":                  (*SearchAnalyzeResult)(nil),
+	"PostSearch":                         (*SearchResult)(nil),
+	"GetSearchDiskSize":                  (*SearchDiskSizeInformation)(nil),
+	"GetSearchInfo":                      (*SearchInfoResult)(nil),
+	"PostReplicator":                     (*DocumentResult)(nil),
+	"DeleteReplicationDocument":          (*DocumentResult)(nil),
+	"GetReplicationDocument":             (*ReplicationDocument)(nil),
+	"PutReplicationDocument":             (*DocumentResult)(nil),
+	"GetSchedulerDocs":                   (*SchedulerDocsResult)(nil),
+	"GetSchedulerDocument":               (*SchedulerDocument)(nil),
+	"GetSchedulerJobs":                   (*SchedulerJobsResult)(nil),
+	"GetSchedulerJob":                    (*SchedulerJob)(nil),
+	"GetSessionInformation":              (*SessionInformation)(nil),
+	"PostApiKeys":                        (*ApiKeysResult)(nil),
+	"PutCloudantSecurityConfiguration":   (*Ok)(nil),
+	"GetSecurity":                        (*Security)(nil),
+	"PutSecurity":                        (*Ok)(nil),
+	"GetCorsInformation":                 (*CorsInformation)(nil),
+	"PutCorsConfiguration":               (*Ok)(nil),
+	"DeleteAttachment":                   (*DocumentResult)(nil),
+	"PutAttachment":                      (*DocumentResult)(nil),
+	"DeleteLocalDocument":                (*DocumentResult)(nil),
+	"GetLocalDocument":                   (*Document)(nil),
+	"PutLocalDocument":                   (*DocumentResult)(nil),
+	"PostRevsDiff":                       map[string]RevsDiff(nil),
+	"GetShardsInformation":               (*ShardsInformation)(nil),
+	"GetDocumentShardsInfo":              (*DocumentShardInfo)(nil),
+	"GetActiveTasks":                     []ActiveTask(nil),
+	"GetActivityTrackerEvents":           (*ActivityTrackerEvents)(nil),
+	"PostActivityTrackerEvents":          (*Ok)(nil),
+	"GetCapacityDatabasesInformation":    (*CapacityDatabasesInformation)(nil),
+	"GetCurrentDatabasesInformation":     (*CurrentDatabasesInformation)(nil),
+	"GetCurrentThroughputInformation":    (*CurrentThroughputInformation)(nil),
+	"GetMembershipInformation":           (*MembershipInformation)(nil),
+	"GetUpInformation":                   (*UpInformation)(nil),
+}
+
+func init() {
+	base.RegisterResponseModels(responseModels)
+}
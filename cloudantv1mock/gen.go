@@ -0,0 +1,238 @@
+//go:build ignore
+
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// gen generates the CloudantV1API interface of the operations
+// of the cloudantv1 package and their Mock implementation.
+//
+// Run it with go generate in the cloudantv1mock directory
+// after regenerating cloudantv1/cloudant_v1.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	source        = "../cloudantv1/cloudant_v1.go"
+	interfaceFile = "../cloudantv1/cloudant_v1_api.go"
+	mockFile      = "mock_operations.go"
+)
+
+const header = `/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by cloudantv1mock/gen.go. DO NOT EDIT.
+
+`
+
+// operation is an operation of the service with its methods.
+type operation struct {
+	name        string
+	doc         string
+	method      *ast.FuncDecl
+	withContext *ast.FuncDecl
+}
+
+func main() {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, source, nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	types := make(map[string]bool)
+	methods := make(map[string]*ast.FuncDecl)
+	var names []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					types[spec.Name.Name] = true
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List[0].Names) == 0 || !decl.Name.IsExported() {
+				continue
+			}
+			if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok && star.X.(*ast.Ident).Name == "CloudantV1" {
+				methods[decl.Name.Name] = decl
+				names = append(names, decl.Name.Name)
+			}
+		}
+	}
+
+	// the operations are the methods with a WithContext variant
+	var operations []operation
+	for _, name := range names {
+		withContext, ok := methods[name+"WithContext"]
+		if !ok {
+			continue
+		}
+		doc := name
+		if m := methods[name]; m.Doc != nil {
+			doc = strings.SplitN(m.Doc.Text(), "\n", 2)[0]
+		}
+		operations = append(operations, operation{name, doc, methods[name], withContext})
+	}
+
+	write(interfaceFile, generateInterface(fset, operations))
+	write(mockFile, generateMock(fset, operations, types))
+}
+
+func generateInterface(fset *token.FileSet, operations []operation) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package cloudantv1\n\n")
+	b.WriteString("import (\n\t\"context\"\n\t\"io\"\n\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n)\n\n")
+	b.WriteString("// CloudantV1API is the interface of the operations of the CloudantV1 service.\n")
+	b.WriteString("// It is implemented by *CloudantV1 and can be mocked in unit tests,\n")
+	b.WriteString("// for example with the Mock of the cloudantv1mock package.\n")
+	b.WriteString("type CloudantV1API interface {\n")
+	for i, op := range operations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\t// %s\n", op.doc)
+		fmt.Fprintf(&b, "\t%s%s\n\n", op.name, signature(fset, op.method.Type, nil))
+		fmt.Fprintf(&b, "\t// %sWithContext is an alternate form of the %s method which supports a Context parameter\n", op.name, op.name)
+		fmt.Fprintf(&b, "\t%sWithContext%s\n", op.name, signature(fset, op.withContext.Type, nil))
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("var _ CloudantV1API = (*CloudantV1)(nil)\n")
+	return b.Bytes()
+}
+
+func generateMock(fset *token.FileSet, operations []operation, types map[string]bool) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package cloudantv1mock\n\n")
+	b.WriteString("import (\n\t\"context\"\n\t\"io\"\n\n\t\"github.com/IBM/cloudant-go-sdk/cloudantv1\"\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n)\n\n")
+	b.WriteString("// Mock is a mock implementation of cloudantv1.CloudantV1API.\n")
+	b.WriteString("// Each operation records its call and calls the function of its Func field,\n")
+	b.WriteString("// or returns an error wrapping ErrUnexpectedCall when the field is nil.\n")
+	b.WriteString("// The operations without a context call their WithContext variant with\n")
+	b.WriteString("// the background context, like the operations of cloudantv1.CloudantV1.\n")
+	b.WriteString("type Mock struct {\n\trecorder\n")
+	for _, op := range operations {
+		fmt.Fprintf(&b, "\n\t// %sFunc implements %s and %sWithContext.\n", op.name, op.name, op.name)
+		fmt.Fprintf(&b, "\t%sFunc func%s\n", op.name, signature(fset, unnamedResults(op.withContext.Type), types))
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("var _ cloudantv1.CloudantV1API = (*Mock)(nil)\n")
+	for _, op := range operations {
+		params := op.withContext.Type.Params.List
+		optionsName := params[1].Names[0].Name
+		fmt.Fprintf(&b, "\n// %s calls %sWithContext with the background context.\n", op.name, op.name)
+		fmt.Fprintf(&b, "func (m *Mock) %s%s {\n", op.name, signature(fset, op.method.Type, types))
+		fmt.Fprintf(&b, "\treturn m.%sWithContext(context.Background(), %s)\n}\n", op.name, optionsName)
+		fmt.Fprintf(&b, "\n// %sWithContext records the call and calls %sFunc.\n", op.name, op.name)
+		fmt.Fprintf(&b, "func (m *Mock) %sWithContext%s {\n", op.name, signature(fset, op.withContext.Type, types))
+		fmt.Fprintf(&b, "\tm.record(%q, ctx, %s)\n", op.name, optionsName)
+		fmt.Fprintf(&b, "\tif m.%sFunc == nil {\n\t\terr = unexpectedCall(%q)\n\t\treturn\n\t}\n", op.name, op.name)
+		fmt.Fprintf(&b, "\treturn m.%sFunc(ctx, %s)\n}\n", op.name, optionsName)
+	}
+	return b.Bytes()
+}
+
+// signature returns the parameters and results of a function type,
+// with the types of the cloudantv1 package qualified when types is not nil.
+func signature(fset *token.FileSet, t *ast.FuncType, types map[string]bool) string {
+	if types != nil {
+		t = qualify(t, types).(*ast.FuncType)
+	}
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, t); err != nil {
+		log.Fatal(err)
+	}
+	return strings.TrimPrefix(b.String(), "func")
+}
+
+// unnamedResults returns a copy of a function type without the names of its results.
+func unnamedResults(t *ast.FuncType) *ast.FuncType {
+	results := &ast.FieldList{}
+	for _, field := range t.Results.List {
+		for range max(len(field.Names), 1) {
+			results.List = append(results.List, &ast.Field{Type: field.Type})
+		}
+	}
+	return &ast.FuncType{Params: t.Params, Results: results}
+}
+
+// qualify returns a copy of an expression with the identifiers of types qualified with cloudantv1.
+func qualify(node ast.Node, types map[string]bool) ast.Node {
+	switch n := node.(type) {
+	case *ast.Ident:
+		if types[n.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent("cloudantv1"), Sel: ast.NewIdent(n.Name)}
+		}
+		return n
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(n.X, types).(ast.Expr)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: n.Len, Elt: qualify(n.Elt, types).(ast.Expr)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(n.Key, types).(ast.Expr), Value: qualify(n.Value, types).(ast.Expr)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(n.Params, types), Results: qualifyFields(n.Results, types)}
+	}
+	return node
+}
+
+func qualifyFields(fields *ast.FieldList, types map[string]bool) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type, types).(ast.Expr)})
+	}
+	return qualified
+}
+
+func write(name string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", name, err, src)
+	}
+	if err := os.WriteFile(name, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,121 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cloudantv1mock provides a Mock implementation of the
+// cloudantv1.CloudantV1API interface for unit tests.
+package cloudantv1mock
+
+//go:generate go run gen.go
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrUnexpectedCall is wrapped by the error returned by the operations
+// of a Mock without a function for the operation.
+var ErrUnexpectedCall = errors.New("unexpected call")
+
+// Call is a call of an operation of a Mock.
+type Call struct {
+	// Operation is the name of the operation, for example GetDocument.
+	Operation string
+	// Context is the context of the call.
+	Context context.Context
+	// Options is the options of the call, for example *cloudantv1.GetDocumentOptions.
+	Options interface{}
+}
+
+// recorder records the calls of a Mock.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(operation string, ctx context.Context, options interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Operation: operation, Context: ctx, Options: options})
+}
+
+// Calls returns the calls of the operations in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsOf returns the calls of an operation in order.
+func (r *recorder) CallsOf(operation string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func unexpectedCall(operation string) error {
+	return fmt.Errorf("%w of %s", ErrUnexpectedCall, operation)
+}
+
+// Response returns a DetailedResponse with a status code and a result,
+// for the successful results of the functions of a Mock.
+func Response(statusCode int, result interface{}) *core.DetailedResponse {
+	return &core.DetailedResponse{
+		StatusCode: statusCode,
+		Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		Result:     result,
+	}
+}
+
+// ErrorResponse returns a DetailedResponse and an error like the ones of
+// the service for an error status code with a Cloudant error and reason,
+// for example ErrorResponse(404, "not_found", "missing").
+func ErrorResponse(statusCode int, errorCode, reason string) (*core.DetailedResponse, error) {
+	result := map[string]interface{}{"error": errorCode, "reason": reason}
+	raw, _ := json.Marshal(result)
+	response := Response(statusCode, result)
+	response.RawResult = raw
+
+	message := errorCode
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", errorCode, reason)
+	}
+	component := common.GetComponentInfo()
+	problem := &core.HTTPProblem{
+		IBMProblem: core.IBMErrorf(errors.New(message), component, "", errorCode),
+		Response:   response,
+	}
+	return response, core.SDKErrorf(problem, "", "http-request-err", component)
+}
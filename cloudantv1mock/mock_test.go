@@ -0,0 +1,160 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cloudantv1mock_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1mock"
+	"github.com/IBM/cloudant-go-sdk/features"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type contextKey struct{}
+
+var _ = Describe(`Mock`, func() {
+	var mock *cloudantv1mock.Mock
+
+	BeforeEach(func() {
+		mock = &cloudantv1mock.Mock{}
+	})
+
+	It(`implements the service interface`, func() {
+		var service cloudantv1.CloudantV1API = mock
+		Expect(service).ToNot(BeNil())
+	})
+
+	It(`returns an unexpected call error without a function`, func() {
+		options := &cloudantv1.GetDocumentOptions{Db: core.StringPtr("db"), DocID: core.StringPtr("doc")}
+		result, response, err := mock.GetDocument(options)
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(errors.Is(err, cloudantv1mock.ErrUnexpectedCall)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("GetDocument"))
+		Expect(mock.Calls()).To(HaveLen(1))
+	})
+
+	It(`calls the function with the context and options`, func() {
+		ctx := context.WithValue(context.Background(), contextKey{}, "value")
+		mock.GetDocumentFunc = func(ctx context.Context, options *cloudantv1.GetDocumentOptions) (*cloudantv1.Document, *core.DetailedResponse, error) {
+			Expect(ctx.Value(contextKey{})).To(Equal("value"))
+			doc := &cloudantv1.Document{ID: options.DocID, Rev: core.StringPtr("1-abc")}
+			return doc, cloudantv1mock.Response(http.StatusOK, doc), nil
+		}
+		options := &cloudantv1.GetDocumentOptions{Db: core.StringPtr("db"), DocID: core.StringPtr("doc")}
+		doc, response, err := mock.GetDocumentWithContext(ctx, options)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetStatusCode()).To(Equal(http.StatusOK))
+		Expect(*doc.ID).To(Equal("doc"))
+		Expect(*doc.Rev).To(Equal("1-abc"))
+	})
+
+	It(`records the calls`, func() {
+		mock.HeadDatabaseFunc = func(context.Context, *cloudantv1.HeadDatabaseOptions) (*core.DetailedResponse, error) {
+			return cloudantv1mock.Response(http.StatusOK, nil), nil
+		}
+		mock.HeadDocumentFunc = func(context.Context, *cloudantv1.HeadDocumentOptions) (*core.DetailedResponse, error) {
+			return cloudantv1mock.Response(http.StatusOK, nil), nil
+		}
+		dbOptions := &cloudantv1.HeadDatabaseOptions{Db: core.StringPtr("db")}
+		docOptions := &cloudantv1.HeadDocumentOptions{Db: core.StringPtr("db"), DocID: core.StringPtr("doc")}
+		_, err := mock.HeadDatabase(dbOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = mock.HeadDocument(docOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = mock.HeadDatabase(dbOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		calls := mock.Calls()
+		Expect(calls).To(HaveLen(3))
+		Expect(calls[0].Operation).To(Equal("HeadDatabase"))
+		Expect(calls[0].Context).To(Equal(context.Background()))
+		Expect(calls[1].Operation).To(Equal("HeadDocument"))
+		Expect(calls[1].Options).To(BeIdenticalTo(docOptions))
+		Expect(mock.CallsOf("HeadDatabase")).To(HaveLen(2))
+		Expect(mock.CallsOf("GetDocument")).To(BeEmpty())
+
+		mock.Reset()
+		Expect(mock.Calls()).To(BeEmpty())
+	})
+
+	It(`returns error responses`, func() {
+		response, err := cloudantv1mock.ErrorResponse(http.StatusNotFound, "not_found", "missing")
+		Expect(response.GetStatusCode()).To(Equal(http.StatusNotFound))
+		Expect(response.GetResult()).To(Equal(map[string]interface{}{"error": "not_found", "reason": "missing"}))
+		Expect(string(response.GetRawResult())).To(MatchJSON(`{"error": "not_found", "reason": "missing"}`))
+		Expect(err.Error()).To(Equal("not_found: missing"))
+		var problem *core.HTTPProblem
+		Expect(errors.As(err, &problem)).To(BeTrue())
+		Expect(problem.Response).To(BeIdenticalTo(response))
+	})
+
+	Describe(`with features`, func() {
+		It(`pages all databases`, func() {
+			mock.GetAllDbsFunc = func(_ context.Context, options *cloudantv1.GetAllDbsOptions) ([]string, *core.DetailedResponse, error) {
+				dbs := []string{"a", "b", "c"}
+				if options.StartKey != nil {
+					dbs = []string{"c"}
+				}
+				return dbs, cloudantv1mock.Response(http.StatusOK, dbs), nil
+			}
+			pagination := features.NewAllDbsPagination(mock, &cloudantv1.GetAllDbsOptions{Limit: core.Int64Ptr(2)})
+			var dbs []string
+			for db, err := range pagination.Rows() {
+				Expect(err).ShouldNot(HaveOccurred())
+				dbs = append(dbs, db)
+			}
+			Expect(dbs).To(Equal([]string{"a", "b", "c"}))
+			Expect(mock.CallsOf("GetAllDbs")).To(HaveLen(2))
+		})
+
+		It(`returns a pagination error`, func() {
+			mock.GetAllDbsFunc = func(context.Context, *cloudantv1.GetAllDbsOptions) ([]string, *core.DetailedResponse, error) {
+				response, err := cloudantv1mock.ErrorResponse(http.StatusForbidden, "forbidden", "server admin access is required")
+				return nil, response, err
+			}
+			pagination := features.NewAllDbsPagination(mock, &cloudantv1.GetAllDbsOptions{})
+			for _, err := range pagination.Pages() {
+				Expect(err).Should(MatchError("forbidden: server admin access is required"))
+			}
+		})
+
+		It(`stops a changes follower on a terminal error`, func() {
+			mock.PostChangesFunc = func(context.Context, *cloudantv1.PostChangesOptions) (*cloudantv1.ChangesResult, *core.DetailedResponse, error) {
+				response, err := cloudantv1mock.ErrorResponse(http.StatusUnauthorized, "unauthorized", "Name or password is incorrect.")
+				return nil, response, err
+			}
+			follower, err := features.NewChangesFollower(mock, &cloudantv1.PostChangesOptions{Db: core.StringPtr("db")})
+			Expect(err).ShouldNot(HaveOccurred())
+			changes, err := follower.StartOneOff()
+			Expect(err).ShouldNot(HaveOccurred())
+			var errs []error
+			for change := range changes {
+				_, err := change.Item()
+				errs = append(errs, err)
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).Should(MatchError("unauthorized: Name or password is incorrect."))
+			Expect(mock.CallsOf("PostChanges")).To(HaveLen(1))
+		})
+	})
+})
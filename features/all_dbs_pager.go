@@ -0,0 +1,76 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var allDbsPagerValidationRules = map[string]string{
+	"Limit": limitValidationRule,
+}
+
+// allDbsResult wraps the database names of an all databases response.
+type allDbsResult struct {
+	Dbs []string
+}
+
+// NewAllDbsPagination creates a new pagination for all databases operations.
+func NewAllDbsPagination(c cloudantv1.CloudantV1API, o *cloudantv1.GetAllDbsOptions) Pagination[string] {
+	return &paginationImplementor[*cloudantv1.GetAllDbsOptions, string]{
+		service:  c,
+		options:  o,
+		newPager: newAllDbsPager,
+	}
+}
+
+// newAllDbsPager creates a new pager for all databases operations.
+func newAllDbsPager(c cloudantv1.CloudantV1API, o *cloudantv1.GetAllDbsOptions) (Pager[string], error) {
+	if err := validatePagerOptions(allDbsPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	opts := *o
+	pd := &keyPager[*cloudantv1.GetAllDbsOptions, *allDbsResult, string]{
+		service:     c,
+		options:     &opts,
+		hasNextPage: true,
+		requestFunction: func(ctx context.Context, o *cloudantv1.GetAllDbsOptions) (*allDbsResult, *core.DetailedResponse, error) {
+			dbs, response, err := c.GetAllDbsWithContext(ctx, o)
+			if err != nil {
+				return nil, response, err
+			}
+			return &allDbsResult{Dbs: dbs}, response, nil
+		},
+		resultItemsGetter: func(result *allDbsResult) []string { return result.Dbs },
+		startKeyGetter:    func(item string) string { return item },
+		startKeySetter:    opts.SetStartKey,
+		optionsCloner: func(o *cloudantv1.GetAllDbsOptions) *cloudantv1.GetAllDbsOptions {
+			opts := *o
+			return &opts
+		},
+		limitGetter: func() *int64 { return opts.Limit },
+		limitSetter: opts.SetLimit,
+		skipSetter:  opts.SetSkip,
+	}
+	p := newResumableBasePager(pd)
+
+	return p, nil
+}
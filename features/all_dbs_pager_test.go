@@ -0,0 +1,137 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newAllDbsServer returns a test server for _all_dbs requests of sorted database names
+func newAllDbsServer(dbs []string) *httptest.Server {
+	return httptest.NewServer(allDbsHandler(dbs))
+}
+
+// allDbsHandler returns a handler for _all_dbs requests of sorted database names
+func allDbsHandler(dbs []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		Expect(r.URL.Path).To(Equal("/_all_dbs"))
+		w.Header().Set("content-type", "application/json")
+		q := r.URL.Query()
+		names := slices.Clone(dbs)
+		descending := q.Get("descending") == "true"
+		if descending {
+			slices.Reverse(names)
+		}
+		after := func(a, b string) bool {
+			if descending {
+				return a < b
+			}
+			return a > b
+		}
+		result := make([]string, 0)
+		for _, db := range names {
+			if q.Has("start_key") && after(q.Get("start_key"), db) {
+				continue
+			}
+			if q.Has("end_key") && after(db, q.Get("end_key")) {
+				continue
+			}
+			result = append(result, db)
+		}
+		skip, _ := strconv.Atoi(q.Get("skip"))
+		result = result[min(skip, len(result)):]
+		if q.Has("limit") {
+			limit, err := strconv.Atoi(q.Get("limit"))
+			Expect(err).ShouldNot(HaveOccurred())
+			result = result[:min(limit, len(result))]
+		}
+		Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+	}
+}
+
+var _ = Describe(`All dbs pager tests`, func() {
+	var (
+		server  *httptest.Server
+		service *cloudantv1.CloudantV1
+		dbs     []string
+	)
+
+	BeforeEach(func() {
+		dbs = make([]string, 0)
+		for i := range 45 {
+			dbs = append(dbs, fmt.Sprintf("db%02d", i))
+		}
+		server = newAllDbsServer(dbs)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Iterates all databases`, func() {
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(10)
+		names := make([]string, 0)
+		for db, err := range NewAllDbsPagination(service, opts).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			names = append(names, db)
+		}
+		Expect(names).To(Equal(dbs))
+	})
+
+	It(`Pages databases from a start key`, func() {
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(10)
+		opts.SetStartKey("db20")
+		pageSizes := make([]int, 0)
+		for page, err := range NewAllDbsPagination(service, opts).Pages() {
+			Expect(err).ShouldNot(HaveOccurred())
+			pageSizes = append(pageSizes, len(page))
+		}
+		Expect(pageSizes).To(Equal([]int{10, 10, 5}))
+	})
+
+	It(`Gets previous pages of databases`, func() {
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(20)
+		runPreviousAssertion(NewAllDbsPagination(service, opts), 3)
+	})
+
+	It(`Validates options`, func() {
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(1000)
+		_, err := NewAllDbsPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -29,7 +29,7 @@ type AllDocsPagerOptions interface {
 }
 
 // NewAllDocsPagination creates a new pagination for all documents operations.
-func NewAllDocsPagination[O AllDocsPagerOptions](c *cloudantv1.CloudantV1, o O) Pagination[cloudantv1.DocsResultRow] {
+func NewAllDocsPagination[O AllDocsPagerOptions](c cloudantv1.CloudantV1API, o O) Pagination[cloudantv1.DocsResultRow] {
 	return &paginationImplementor[O, cloudantv1.DocsResultRow]{
 		service:  c,
 		options:  o,
@@ -38,7 +38,7 @@ func NewAllDocsPagination[O AllDocsPagerOptions](c *cloudantv1.CloudantV1, o O)
 }
 
 // newAllDocsPager creates a new pager for all documents operations.
-func newAllDocsPager[O AllDocsPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[cloudantv1.DocsResultRow], error) {
+func newAllDocsPager[O AllDocsPagerOptions](c cloudantv1.CloudantV1API, o O) (Pager[cloudantv1.DocsResultRow], error) {
 	if err := validatePagerOptions(keyPagerValidationRules, o); err != nil {
 		if errors.Is(err, ErrKeySet) {
 			err = fmt.Errorf(`%w. No need to paginate as "Key" returns a single result for an ID`, err)
@@ -49,12 +49,12 @@ func newAllDocsPager[O AllDocsPagerOptions](c *cloudantv1.CloudantV1, o O) (Page
 	switch opts := any(o).(type) {
 	case *cloudantv1.PostAllDocsOptions:
 		pd := newAllDocsKeyPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	case *cloudantv1.PostPartitionAllDocsOptions:
 		pd := newAllDocsPartitionKeyPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	}
@@ -62,7 +62,7 @@ func newAllDocsPager[O AllDocsPagerOptions](c *cloudantv1.CloudantV1, o O) (Page
 	return nil, ErrNotImplemented
 }
 
-func newAllDocsKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostAllDocsOptions) *keyPager[*cloudantv1.PostAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow] {
+func newAllDocsKeyPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostAllDocsOptions) *keyPager[*cloudantv1.PostAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow] {
 	opts := *o
 	return &keyPager[*cloudantv1.PostAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow]{
 		service:           c,
@@ -87,7 +87,7 @@ func newAllDocsKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostAllDocsOptio
 	}
 }
 
-func newAllDocsPartitionKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostPartitionAllDocsOptions) *keyPager[*cloudantv1.PostPartitionAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow] {
+func newAllDocsPartitionKeyPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionAllDocsOptions) *keyPager[*cloudantv1.PostPartitionAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow] {
 	opts := *o
 	return &keyPager[*cloudantv1.PostPartitionAllDocsOptions, *cloudantv1.AllDocsResult, cloudantv1.DocsResultRow]{
 		service:           c,
@@ -34,23 +34,25 @@ var limitValidationRule = fmt.Sprintf("omitempty,min=%d,max=%d", minLimit, maxLi
 
 var ErrNotImplemented = errors.New("not yet implemented")
 var ErrNoMoreResults = errors.New("no more results available")
+var ErrNoPreviousResults = errors.New("no previous results available")
 var ErrKeySet = errors.New(`the option "Key" is invalid when using pagination`)
 
 type pagerOptions interface {
-	keyPagerOptions | bookmarkPagerOptions
+	keyPagerOptions | bookmarkPagerOptions | skipPagerOptions | seqPagerOptions
 }
 
 type requestResult interface {
-	keyRequestResult | bookmarkRequestResult
+	keyRequestResult | bookmarkRequestResult | skipRequestResult | seqRequestResult
 }
 
 type paginatedRow interface {
-	keyPaginatedRow | bookmarkPaginatedRow
+	keyPaginatedRow | bookmarkPaginatedRow | skipPaginatedRow | seqPaginatedRow
 }
 
 // PaginatedRow defines a response object for Pager operations
 type PaginatedRow interface {
-	cloudantv1.DocsResultRow | cloudantv1.ViewResultRow | cloudantv1.Document | cloudantv1.SearchResultRow
+	cloudantv1.DocsResultRow | cloudantv1.ViewResultRow | cloudantv1.Document | cloudantv1.SearchResultRow | cloudantv1.SearchResultProperties |
+		string | cloudantv1.SchedulerDocument | cloudantv1.SchedulerJob | cloudantv1.ChangesResultItem
 }
 
 // Pager is an interface for pagination of Cloudant query operations.
@@ -71,6 +73,46 @@ type Pager[T PaginatedRow] interface {
 	GetAllWithContext(context.Context) ([]T, error)
 }
 
+// TokenPager is a Pager that can be resumed from a token.
+// Pagers of key and bookmark based operations implement it,
+// which callers can check with a type assertion.
+type TokenPager[T PaginatedRow] interface {
+	Pager[T]
+
+	// Token returns an opaque token signed with the key for resuming
+	// pagination from the next page with a NewXxxPaginationFromToken function.
+	Token(key []byte) (string, error)
+}
+
+// BidirectionalPager is a Pager that can also go back to previous pages.
+// Pagers of key and bookmark based operations implement it,
+// which callers can check with a type assertion.
+type BidirectionalPager[T PaginatedRow] interface {
+	Pager[T]
+
+	// HasPrevious returns false if there is no page before the current page.
+	HasPrevious() bool
+
+	// GetPrevious retrieves the page before the current page.
+	GetPrevious() ([]T, error)
+
+	// GetPreviousWithContext retrieves the page before the current page with user provided context.
+	GetPreviousWithContext(context.Context) ([]T, error)
+}
+
+// resumablePager is a Pager implementing both TokenPager and BidirectionalPager.
+type resumablePager[T PaginatedRow] interface {
+	TokenPager[T]
+	BidirectionalPager[T]
+}
+
+// previousPageImplementor is an internal interface of callbacks
+// for pagers supporting previous pages
+type previousPageImplementor[O pagerOptions, T paginatedRow] interface {
+	hasPrevious() bool
+	getPrevious(ctx context.Context, initial O, pageSize int64) ([]T, error)
+}
+
 // pagerImplementor is an internal interface of callbacks necessary for Pager interface implementation
 type pagerImplementor[O pagerOptions, R requestResult, T paginatedRow] interface {
 	nextRequestFunction(context.Context) (R, error)
@@ -106,6 +148,24 @@ func newBasePager[O pagerOptions, R requestResult, T paginatedRow](pd pagerImple
 	}
 }
 
+// resumableBasePager is a basePager for pagers that can save their position
+// and go back to previous pages, implementing TokenPager and BidirectionalPager.
+type resumableBasePager[O pagerOptions, R requestResult, T paginatedRow] struct {
+	*basePager[O, R, T]
+	previous previousPageImplementor[O, T]
+	position positionedPager
+}
+
+// newResumableBasePager creates a new base pager for key and bookmark based operations.
+// The pager implementor must also implement previousPageImplementor and positionedPager.
+func newResumableBasePager[O pagerOptions, R requestResult, T paginatedRow](pd pagerImplementor[O, R, T]) resumablePager[T] {
+	return &resumableBasePager[O, R, T]{
+		basePager: newBasePager(pd).(*basePager[O, R, T]),
+		previous:  pd.(previousPageImplementor[O, T]),
+		position:  pd.(positionedPager),
+	}
+}
+
 // HasNext returns false if there are no more pages.
 func (p *basePager[O, R, T]) HasNext() bool {
 	return p.pager.hasNext()
@@ -129,7 +189,11 @@ func (p *basePager[O, R, T]) GetNextWithContext(ctx context.Context) ([]T, error
 	if err != nil {
 		return nil, err
 	}
-	err = validatePagerResponse(result)
+	if v, ok := p.pager.(responseValidator[R]); ok {
+		err = v.validateResponse(result)
+	} else {
+		err = validatePagerResponse(result)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +229,54 @@ func (p *basePager[O, R, T]) GetAllWithContext(ctx context.Context) ([]T, error)
 	return acc, nil
 }
 
+// HasPrevious returns false if there is no page before the current page.
+func (p *resumableBasePager[O, R, T]) HasPrevious() bool {
+	return p.previous.hasPrevious()
+}
+
+// GetPrevious retrieves the page before the current page.
+func (p *resumableBasePager[O, R, T]) GetPrevious() ([]T, error) {
+	return p.GetPreviousWithContext(context.Background())
+}
+
+// GetPreviousWithContext retrieves the page before the current page with user provided context.
+func (p *resumableBasePager[O, R, T]) GetPreviousWithContext(ctx context.Context) ([]T, error) {
+	if p.err != nil {
+		return nil, p.err
+	} else if !p.previous.hasPrevious() {
+		return nil, ErrNoPreviousResults
+	}
+	return p.previous.getPrevious(ctx, p.options, p.pageSize)
+}
+
+// Token returns an opaque token signed with the key for resuming
+// pagination from the next page with a NewXxxPaginationFromToken function.
+func (p *resumableBasePager[O, R, T]) Token(key []byte) (string, error) {
+	position, err := p.position.getPosition()
+	if err != nil {
+		return "", err
+	}
+	return encodePagerToken(p.options, position, key)
+}
+
+// getPosition returns the position of the pager before its next page.
+func (p *basePager[O, R, T]) getPosition() (pagerPosition, error) {
+	pager, ok := p.pager.(positionedPager)
+	if !ok {
+		return pagerPosition{}, ErrNotImplemented
+	}
+	return pager.getPosition()
+}
+
+// setPosition moves the pager to a position from a token.
+func (p *basePager[O, R, T]) setPosition(position pagerPosition) error {
+	pager, ok := p.pager.(positionedPager)
+	if !ok {
+		return ErrNotImplemented
+	}
+	return pager.setPosition(position)
+}
+
 // getPageSizeFromOptionsLimit infers pageSize from options limit or defaults to 200.
 func getPageSizeFromOptionsLimit[O pagerOptions, R requestResult, T paginatedRow](pd pagerImplementor[O, R, T]) int64 {
 	pageSize := int64(maxLimit)
@@ -175,7 +287,7 @@ func getPageSizeFromOptionsLimit[O pagerOptions, R requestResult, T paginatedRow
 }
 
 // validatePagerOptions validates the options struct using the provided rules.
-func validatePagerOptions[O pagerOptions](rules map[string]string, options O) error {
+func validatePagerOptions[O pagerOptions | queriesPagerQuery](rules map[string]string, options O) error {
 	validate := validator.New()
 	validate.RegisterStructValidationMapRules(rules, options)
 	err := validate.Struct(options)
@@ -200,6 +312,12 @@ func validatePagerOptions[O pagerOptions](rules map[string]string, options O) er
 	return err
 }
 
+// responseValidator is implemented by the pagers that validate
+// their responses instead of validatePagerResponse.
+type responseValidator[R requestResult] interface {
+	validateResponse(R) error
+}
+
 // validatePagerResponse validates the response struct using the provided rules.
 func validatePagerResponse[R requestResult](response R) error {
 	validate := validator.New()
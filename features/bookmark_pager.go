@@ -18,11 +18,16 @@ package features
 
 import (
 	"context"
+	"slices"
 
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 )
 
+// BookmarkHistorySize is the maximum number of previous pages
+// a bookmark based pager can go back to.
+const BookmarkHistorySize = 100
+
 var (
 	bookmarkPagerValidationRules = map[string]string{
 		"Limit": limitValidationRule,
@@ -35,6 +40,18 @@ var (
 		"Ranges":     "isdefault",
 		"Limit":      limitValidationRule,
 	}
+	facetedSearchPagerValidationRules = map[string]string{
+		"GroupField": "isdefault",
+		"GroupLimit": "isdefault",
+		"GroupSort":  "isdefault",
+		"Limit":      limitValidationRule,
+	}
+	groupedSearchPagerValidationRules = map[string]string{
+		"Counts":     "isdefault",
+		"Ranges":     "isdefault",
+		"GroupLimit": limitValidationRule,
+		"Limit":      limitValidationRule,
+	}
 )
 
 type bookmarkPagerOptions interface {
@@ -46,11 +63,11 @@ type bookmarkRequestResult interface {
 }
 
 type bookmarkPaginatedRow interface {
-	cloudantv1.Document | cloudantv1.SearchResultRow
+	cloudantv1.Document | cloudantv1.SearchResultRow | cloudantv1.SearchResultProperties
 }
 
 type bookmarkPager[O bookmarkPagerOptions, R bookmarkRequestResult, T bookmarkPaginatedRow] struct {
-	service           *cloudantv1.CloudantV1
+	service           cloudantv1.CloudantV1API
 	options           O
 	hasNextPage       bool
 	requestFunction   func(context.Context, O) (R, *core.DetailedResponse, error)
@@ -61,6 +78,8 @@ type bookmarkPager[O bookmarkPagerOptions, R bookmarkRequestResult, T bookmarkPa
 	limitGetter       func() *int64
 	limitSetter       func(int64) O
 	skipSetter        func(int64) O
+	nextBookmark      *string
+	bookmarks         []*string
 }
 
 func (p *bookmarkPager[O, R, T]) nextRequestFunction(ctx context.Context) (R, error) {
@@ -70,6 +89,11 @@ func (p *bookmarkPager[O, R, T]) nextRequestFunction(ctx context.Context) (R, er
 
 func (p *bookmarkPager[O, R, T]) itemsGetter(result R) ([]T, error) {
 	items := p.resultItemsGetter(result)
+	// keep the bookmarks of the current page and of up to BookmarkHistorySize previous pages
+	p.bookmarks = append(p.bookmarks, p.nextBookmark)
+	if len(p.bookmarks) > BookmarkHistorySize+1 {
+		p.bookmarks = slices.Delete(p.bookmarks, 0, 1)
+	}
 	if p.limitGetter() != nil && len(items) < int(*p.limitGetter()) {
 		p.hasNextPage = false
 	}
@@ -86,6 +110,27 @@ func (p *bookmarkPager[O, R, T]) setNextPageOptions(result R) {
 	}
 	bookmark := p.bookmarkGetter(result)
 	p.bookmarkSetter(bookmark)
+	p.nextBookmark = &bookmark
+}
+
+func (p *bookmarkPager[O, R, T]) getPosition() (pagerPosition, error) {
+	position := pagerPosition{Done: !p.hasNextPage}
+	if !position.Done {
+		position.Bookmark = p.nextBookmark
+	}
+	return position, nil
+}
+
+func (p *bookmarkPager[O, R, T]) setPosition(position pagerPosition) error {
+	p.hasNextPage = !position.Done
+	if position.Bookmark != nil {
+		if p.skipSetter != nil {
+			p.skipSetter(0)
+		}
+		p.bookmarkSetter(*position.Bookmark)
+		p.nextBookmark = position.Bookmark
+	}
+	return nil
 }
 
 func (p *bookmarkPager[O, R, T]) getOptions() O {
@@ -103,3 +148,72 @@ func (p *bookmarkPager[O, R, T]) getLimit() *int64 {
 func (p *bookmarkPager[O, R, T]) setLimit(pageSize int64) {
 	p.limitSetter(pageSize)
 }
+
+func (p *bookmarkPager[O, R, T]) hasPrevious() bool {
+	return len(p.bookmarks) > 1
+}
+
+// getPrevious retrieves the page before the current page
+// with the bookmark from the history.
+func (p *bookmarkPager[O, R, T]) getPrevious(ctx context.Context, initial O, pageSize int64) ([]T, error) {
+	opts := previousBookmarkPageOptions(initial, pageSize, p.bookmarks[len(p.bookmarks)-2])
+	result, _, err := p.requestFunction(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePagerResponse(result); err != nil {
+		return nil, err
+	}
+
+	items := p.resultItemsGetter(result)
+	p.bookmarks = p.bookmarks[:len(p.bookmarks)-1]
+	p.hasNextPage = true
+	if p.skipSetter != nil {
+		p.skipSetter(0)
+	}
+	bookmark := p.bookmarkGetter(result)
+	p.bookmarkSetter(bookmark)
+	p.nextBookmark = &bookmark
+	return items, nil
+}
+
+// previousBookmarkPageOptions returns a copy of the options with the limit and bookmark.
+// Without a bookmark the copy reads the first page.
+func previousBookmarkPageOptions[O bookmarkPagerOptions](o O, limit int64, bookmark *string) O {
+	switch opts := any(o).(type) {
+	case *cloudantv1.PostFindOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if bookmark != nil {
+			c.Bookmark, c.Skip = bookmark, nil
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionFindOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if bookmark != nil {
+			c.Bookmark, c.Skip = bookmark, nil
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostSearchOptions:
+		c := *opts
+		if c.GroupField != nil {
+			// grouped searches are paged by groups
+			c.SetGroupLimit(limit)
+		} else {
+			c.SetLimit(limit)
+		}
+		if bookmark != nil {
+			c.Bookmark = bookmark
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionSearchOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if bookmark != nil {
+			c.Bookmark = bookmark
+		}
+		return any(&c).(O)
+	}
+	return o
+}
@@ -27,6 +27,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/IBM/cloudant-go-sdk/base"
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/cloudant-go-sdk/common"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -115,14 +116,17 @@ const (
 // the PostChangesOptions's "Filter" option.
 // Selector based filters perform better than JS based filters and using one
 // of the alternative JS based filter types will cause ChangesFollower
-// to return an Error.
+// to return an Error, unless the ChangesFollower is created with
+// NewFilterFunctionChangesFollower to opt in to other filters.
+// The additional query parameters of the context from base.WithQueryParams
+// must not be the query parameters of the named attributes.
 // It should also be noted that the "Limit" parameter will truncate the
 // response at the given number of changes in either operating mode.
 // The ChangesFollower requires the Cloudant client to have HTTP timeout
 // of at least 1 minute.
 // The default client configuration has a sufficiently long timeout.
 type ChangesFollower struct {
-	client           *cloudantv1.CloudantV1
+	client           cloudantv1.CloudantV1API
 	options          *cloudantv1.PostChangesOptions
 	mode             Mode
 	since            string
@@ -158,21 +162,46 @@ func (ci ChangesItem) Item() (cloudantv1.ChangesResultItem, error) {
 
 // NewChangesFollower returns a new ChangesFollower or an error if provided
 // configuration is invalid.
-func NewChangesFollower(c *cloudantv1.CloudantV1, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
+func NewChangesFollower(c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
 	ctx := context.Background()
 	return NewChangesFollowerWithContext(ctx, c, o)
 }
 
 // NewChangesFollowerWithContext returns a new ChangesFollower initiated
 // with a given context or an error if provided configuration is invalid.
-func NewChangesFollowerWithContext(ctx context.Context, c *cloudantv1.CloudantV1, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
-	err := validateOptions(o)
+func NewChangesFollowerWithContext(ctx context.Context, c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
+	return newChangesFollower(ctx, c, o, false)
+}
+
+// NewFilterFunctionChangesFollower returns a new ChangesFollower that permits
+// any value of the PostChangesOptions's "Filter" option, for example a design
+// document filter function "ddoc/filter" with its parameters in the context from base.WithQueryParams,
+// or an error if provided configuration is invalid.
+// Filter functions perform worse than selector based filters,
+// prefer "_selector" for new filters.
+func NewFilterFunctionChangesFollower(c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
+	ctx := context.Background()
+	return NewFilterFunctionChangesFollowerWithContext(ctx, c, o)
+}
+
+// NewFilterFunctionChangesFollowerWithContext returns a new ChangesFollower
+// permitting any filter initiated with a given context or an error
+// if provided configuration is invalid.
+func NewFilterFunctionChangesFollowerWithContext(ctx context.Context, c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) (*ChangesFollower, error) {
+	return newChangesFollower(ctx, c, o, true)
+}
+
+func newChangesFollower(ctx context.Context, c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions, filterFunctions bool) (*ChangesFollower, error) {
+	err := validateOptions(o, filterFunctions)
+	if err != nil {
+		return nil, err
+	}
+	err = base.ValidateQueryParams(base.QueryParams(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	client := c.Service.GetHTTPClient()
-	if client.Timeout > 0 && client.Timeout < minClientTimeout {
+	if client := httpClient(c); client != nil && client.Timeout > 0 && client.Timeout < minClientTimeout {
 		err := fmt.Errorf("to use ChangesFollower the client timeout must be at least %d ms. The client timeout is %d ms", minClientTimeout/time.Millisecond, client.Timeout/time.Millisecond)
 		return nil, core.SDKErrorf(err, "", "changes-follower-invalid-timeout", common.GetComponentInfo())
 	}
@@ -256,7 +285,19 @@ func (cf *ChangesFollower) Stop() {
 	cf.cancel()
 }
 
-func validateOptions(o *cloudantv1.PostChangesOptions) error {
+// httpClient returns the HTTP client of a CloudantV1 service,
+// or nil for other implementations of the service interface.
+func httpClient(c cloudantv1.CloudantV1API) *http.Client {
+	switch c := c.(type) {
+	case *cloudantv1.CloudantV1:
+		return c.Service.GetHTTPClient()
+	case *MultiEndpointClient:
+		return c.Service.GetHTTPClient()
+	}
+	return nil
+}
+
+func validateOptions(o *cloudantv1.PostChangesOptions, filterFunctions bool) error {
 	// this validates that database was properly set
 	err := core.ValidateStruct(o, "postChangesOptions")
 	if err != nil {
@@ -280,7 +321,7 @@ func validateOptions(o *cloudantv1.PostChangesOptions) error {
 	if o.Timeout != nil {
 		errAttrs = append(errAttrs, "timeout")
 	}
-	if o.Filter != nil && *o.Filter != "_selector" {
+	if o.Filter != nil && *o.Filter != "_selector" && !filterFunctions {
 		errAttrs = append(errAttrs, fmt.Sprintf("filter=%s", *o.Filter))
 	}
 	if len(errAttrs) == 1 {
@@ -336,7 +377,7 @@ func (cf *ChangesFollower) run(m Mode) (<-chan ChangesItem, error) {
 
 	batchSize := BatchSize
 	if cf.options.IncludeDocs != nil && *cf.options.IncludeDocs {
-		o := cf.client.NewGetDatabaseInformationOptions(*cf.options.Db)
+		o := &cloudantv1.GetDatabaseInformationOptions{Db: cf.options.Db}
 
 		result, _, err := cf.client.GetDatabaseInformation(o)
 		if err != nil {
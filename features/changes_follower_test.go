@@ -20,8 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"time"
 
+	"github.com/IBM/cloudant-go-sdk/base"
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 
@@ -312,6 +316,72 @@ var _ = Describe(`ChangesFollower options`, func() {
 	})
 })
 
+var _ = Describe(`ChangesFollower filter functions`, func() {
+	It(`Permits filter functions only when opted in`, func() {
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           "http://localhost:5984",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		postChangesOptions := service.NewPostChangesOptions("db").SetFilter("ddoc/byName")
+
+		_, err = NewChangesFollower(service, postChangesOptions)
+		Expect(err).To(MatchError(ContainSubstring("filter=ddoc/byName")))
+
+		follower, err := NewFilterFunctionChangesFollower(service, postChangesOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(follower).ToNot(BeNil())
+
+		ctx := base.WithQueryParams(context.Background(), map[string]string{"name": "Jane", "since": "now"})
+		_, err = NewFilterFunctionChangesFollowerWithContext(ctx, service, service.NewPostChangesOptions("db").SetFilter("ddoc/byName"))
+		Expect(err).To(MatchError("the query parameters since are reserved for the named options"))
+
+		postChangesOptions.SetDescending(true)
+		_, err = NewFilterFunctionChangesFollowerWithContext(context.Background(), service, postChangesOptions)
+		Expect(err).To(MatchError(ContainSubstring("descending")))
+	})
+
+	It(`Sends the query parameters of the filter function`, func() {
+		queries := make([]url.Values, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.Query())
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprint(w, `{"results":[{"id":"a","seq":"1-a","changes":[{"rev":"1-a"}]}],"last_seq":"1-a","pending":0}`)
+		}))
+		defer server.Close()
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		postChangesOptions := service.NewPostChangesOptions("db").SetFilter("ddoc/byName")
+		ctx := base.WithQueryParams(context.Background(), map[string]string{"name": "Jane"})
+		follower, err := NewFilterFunctionChangesFollowerWithContext(ctx, service, postChangesOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+		changesCh, err := follower.StartOneOff()
+		Expect(err).ShouldNot(HaveOccurred())
+		count := 0
+		for ci := range changesCh {
+			_, err := ci.Item()
+			Expect(err).ShouldNot(HaveOccurred())
+			count++
+		}
+		Expect(count).To(Equal(1))
+		Expect(queries).ToNot(BeEmpty())
+		Expect(queries[0].Get("filter")).To(Equal("ddoc/byName"))
+		Expect(queries[0].Get("name")).To(Equal("Jane"))
+
+		stream, _, err := service.PostChangesAsStreamWithContext(ctx, postChangesOptions)
+		Expect(err).ShouldNot(HaveOccurred())
+		stream.Close()
+		Expect(queries[len(queries)-1].Get("name")).To(Equal("Jane"))
+
+		_, _, err = service.PostChangesWithContext(base.WithQueryParams(ctx, map[string]string{"filter": "other"}), postChangesOptions)
+		Expect(err).To(MatchError(ContainSubstring("query parameters filter are reserved")))
+	})
+})
+
 var _ = Describe(`ChangesFollower finite`, func() {
 	var p = 100 * time.Millisecond
 
@@ -0,0 +1,139 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/go-playground/validator/v10"
+)
+
+var changesPagerValidationRules = map[string]string{
+	"Descending":  "isdefault",
+	"Heartbeat":   "isdefault",
+	"LastEventID": "isdefault",
+	"Timeout":     "isdefault",
+	"Limit":       limitValidationRule,
+}
+
+type seqPagerOptions interface {
+	*cloudantv1.PostChangesOptions
+}
+
+type seqRequestResult interface {
+	*cloudantv1.ChangesResult
+}
+
+type seqPaginatedRow interface {
+	cloudantv1.ChangesResultItem
+}
+
+// NewChangesPagination creates a new pagination for one-off changes operations.
+// Each page starts from the last sequence of the previous page and
+// the pagination ends when there are no pending changes.
+// Use a ChangesFollower to keep listening for new changes.
+func NewChangesPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) Pagination[cloudantv1.ChangesResultItem] {
+	return &paginationImplementor[*cloudantv1.PostChangesOptions, cloudantv1.ChangesResultItem]{
+		service:  c,
+		options:  o,
+		newPager: newChangesPager,
+	}
+}
+
+// newChangesPager creates a new pager for one-off changes operations.
+func newChangesPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostChangesOptions) (Pager[cloudantv1.ChangesResultItem], error) {
+	if o.Feed != nil && *o.Feed != cloudantv1.PostChangesOptionsFeedNormalConst {
+		return nil, errors.New(`the option "Feed" is invalid when using pagination`)
+	}
+	if err := validatePagerOptions(changesPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	opts := *o
+	pd := &seqPager{
+		service:     c,
+		options:     &opts,
+		hasNextPage: true,
+	}
+	p := newBasePager(pd)
+
+	return p, nil
+}
+
+// seqPager pages through the changes feed from the last sequence of each page.
+type seqPager struct {
+	service     cloudantv1.CloudantV1API
+	options     *cloudantv1.PostChangesOptions
+	hasNextPage bool
+}
+
+func (p *seqPager) nextRequestFunction(ctx context.Context) (*cloudantv1.ChangesResult, error) {
+	result, _, err := p.service.PostChangesWithContext(ctx, p.options)
+	return result, err
+}
+
+func (p *seqPager) itemsGetter(result *cloudantv1.ChangesResult) ([]cloudantv1.ChangesResultItem, error) {
+	switch {
+	case len(result.Results) == 0:
+		p.hasNextPage = false
+	case result.Pending != nil:
+		p.hasNextPage = *result.Pending > 0
+	default:
+		// without pending changes, for example from older servers,
+		// only a page that is not full is the last one
+		p.hasNextPage = p.options.Limit == nil || int64(len(result.Results)) >= *p.options.Limit
+	}
+	if p.hasNextPage && result.LastSeq == nil {
+		p.hasNextPage = false
+		return result.Results, errors.New("the changes result has no last sequence to start the next page from")
+	}
+	return result.Results, nil
+}
+
+// validateResponse validates a changes result except its pending changes and last sequence,
+// which older servers or proxies may leave out and are checked by itemsGetter.
+func (p *seqPager) validateResponse(result *cloudantv1.ChangesResult) error {
+	return validator.New().StructExcept(result, "Pending", "LastSeq")
+}
+
+func (p *seqPager) hasNext() bool {
+	return p.hasNextPage
+}
+
+func (p *seqPager) setNextPageOptions(result *cloudantv1.ChangesResult) {
+	p.options.SetSince(*result.LastSeq)
+}
+
+func (p *seqPager) getOptions() *cloudantv1.PostChangesOptions {
+	opts := *p.options
+	return &opts
+}
+
+func (p *seqPager) setOptions(o *cloudantv1.PostChangesOptions) {
+	opts := *o
+	p.options = &opts
+}
+
+func (p *seqPager) getLimit() *int64 {
+	return p.options.Limit
+}
+
+func (p *seqPager) setLimit(pageSize int64) {
+	p.options.SetLimit(pageSize)
+}
@@ -0,0 +1,188 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newChangesServer returns a test server for one-off changes requests
+// with sequences numbered from 1, without the omitted result properties
+func newChangesServer(total int, since *[]string, omit ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		Expect(r.URL.Path).To(Equal("/db/_changes"))
+		w.Header().Set("content-type", "application/json")
+		q := r.URL.Query()
+		*since = append(*since, q.Get("since"))
+		start := 0
+		if q.Has("since") && q.Get("since") != "0" {
+			seq, _, _ := strings.Cut(q.Get("since"), "-")
+			var err error
+			start, err = strconv.Atoi(seq)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		limit, err := strconv.Atoi(q.Get("limit"))
+		Expect(err).ShouldNot(HaveOccurred())
+		end := min(start+limit, total)
+		results := make([]map[string]any, 0)
+		for i := start + 1; i <= end; i++ {
+			results = append(results, map[string]any{
+				"id":      fmt.Sprintf("doc%02d", i),
+				"seq":     fmt.Sprintf("%d-abc", i),
+				"changes": []map[string]any{{"rev": "1-a"}},
+			})
+		}
+		result := map[string]any{
+			"last_seq": fmt.Sprintf("%d-abc", end),
+			"pending":  total - end,
+			"results":  results,
+		}
+		for _, name := range omit {
+			delete(result, name)
+		}
+		Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Changes pager tests`, func() {
+	var (
+		server  *httptest.Server
+		service *cloudantv1.CloudantV1
+		since   []string
+	)
+
+	start := func(total int, omit ...string) {
+		since = nil
+		server = newChangesServer(total, &since, omit...)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Iterates changes from the last sequence of each page`, func() {
+		start(25)
+		opts := service.NewPostChangesOptions("db")
+		opts.SetLimit(10)
+		ids := make([]string, 0)
+		for change, err := range NewChangesPagination(service, opts).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			ids = append(ids, *change.ID)
+		}
+		Expect(ids).To(HaveLen(25))
+		Expect(ids[24]).To(Equal("doc25"))
+		Expect(since).To(Equal([]string{"", "10-abc", "20-abc"}))
+	})
+
+	It(`Ends when there are no pending changes`, func() {
+		start(20)
+		opts := service.NewPostChangesOptions("db")
+		opts.SetLimit(10)
+		opts.SetSince("0")
+		pager, err := NewChangesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		all, err := pager.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(all).To(HaveLen(20))
+		Expect(since).To(Equal([]string{"0", "10-abc"}))
+	})
+
+	It(`Ends on a page that is not full without pending changes`, func() {
+		for _, c := range []struct {
+			total int
+			since []string
+		}{
+			{25, []string{"", "10-abc", "20-abc"}},
+			{20, []string{"", "10-abc", "20-abc"}},
+			{5, []string{""}},
+		} {
+			start(c.total, "pending")
+			opts := service.NewPostChangesOptions("db")
+			opts.SetLimit(10)
+			ids := make([]string, 0)
+			for change, err := range NewChangesPagination(service, opts).Rows() {
+				Expect(err).ShouldNot(HaveOccurred())
+				ids = append(ids, *change.ID)
+			}
+			Expect(ids).To(HaveLen(c.total))
+			Expect(since).To(Equal(c.since))
+			server.Close()
+		}
+		start(0)
+	})
+
+	It(`Fails to get the next page without a last sequence`, func() {
+		start(25, "last_seq")
+		opts := service.NewPostChangesOptions("db")
+		opts.SetLimit(10)
+		pager, err := NewChangesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		page, err := pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(HaveLen(10))
+		_, err = pager.GetNext()
+		Expect(err).Should(MatchError("the changes result has no last sequence to start the next page from"))
+		Expect(since).To(Equal([]string{""}))
+
+		// a last page does not need a last sequence
+		server.Close()
+		start(5, "last_seq")
+		all, err := NewChangesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		items, err := all.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(items).To(HaveLen(5))
+	})
+
+	It(`Validates options`, func() {
+		start(0)
+		opts := service.NewPostChangesOptions("db")
+		opts.SetFeed(cloudantv1.PostChangesOptionsFeedLongpollConst)
+		_, err := NewChangesPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+
+		opts = service.NewPostChangesOptions("db")
+		opts.SetFeed(cloudantv1.PostChangesOptionsFeedNormalConst)
+		opts.SetTimeout(1000)
+		_, err = NewChangesPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+
+		for _, descending := range []bool{true, false} {
+			opts = service.NewPostChangesOptions("db")
+			opts.SetDescending(descending)
+			_, err = NewChangesPagination(service, opts).Pager()
+			Expect(err).Should(HaveOccurred())
+		}
+	})
+})
@@ -0,0 +1,262 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PartitionPagerOptions defines options for paginating through all the partitions of a partitioned database.
+type PartitionPagerOptions interface {
+	*cloudantv1.PostPartitionAllDocsOptions | *cloudantv1.PostPartitionViewOptions | *cloudantv1.PostPartitionFindOptions | *cloudantv1.PostPartitionSearchOptions
+}
+
+// NewAllPartitionsAllDocsPagination creates a new pagination for all documents of all partitions.
+// The partition key of the options is ignored.
+func NewAllPartitionsAllDocsPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionAllDocsOptions) Pagination[cloudantv1.DocsResultRow] {
+	return newAllPartitionsPagination[*cloudantv1.PostPartitionAllDocsOptions, *cloudantv1.AllDocsResult](c, o, newAllDocsPager[*cloudantv1.PostPartitionAllDocsOptions], newAllDocsPartitionKeyPager)
+}
+
+// NewAllPartitionsViewPagination creates a new pagination for a view of all partitions.
+// The partition key of the options is ignored.
+func NewAllPartitionsViewPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionViewOptions) Pagination[cloudantv1.ViewResultRow] {
+	return newAllPartitionsPagination[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult](c, o, newViewPager[*cloudantv1.PostPartitionViewOptions], newViewPartitionKeyPager)
+}
+
+// NewAllPartitionsFindPagination creates a new pagination for a query of all partitions.
+// The partition key of the options is ignored.
+func NewAllPartitionsFindPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionFindOptions) Pagination[cloudantv1.Document] {
+	return newAllPartitionsPagination[*cloudantv1.PostPartitionFindOptions, *cloudantv1.FindResult](c, o, newFindPager[*cloudantv1.PostPartitionFindOptions], newFindPartitionBookmarkPager)
+}
+
+// NewAllPartitionsSearchPagination creates a new pagination for a search of all partitions.
+// The partition key of the options is ignored.
+func NewAllPartitionsSearchPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionSearchOptions) Pagination[cloudantv1.SearchResultRow] {
+	return newAllPartitionsPagination[*cloudantv1.PostPartitionSearchOptions, *cloudantv1.SearchResult](c, o, newSearchPager[*cloudantv1.PostPartitionSearchOptions], newSearchPartitionBookmarkPager)
+}
+
+// newAllPartitionsPagination creates a new pagination chaining the pager implementors of each partition.
+// The partition pager validates the options.
+func newAllPartitionsPagination[O PartitionPagerOptions, R requestResult, T paginatedRow, P pagerImplementor[O, R, T]](c cloudantv1.CloudantV1API, o O, newPartitionPager func(cloudantv1.CloudantV1API, O) (Pager[T], error), newPartitionImplementor func(cloudantv1.CloudantV1API, O) P) Pagination[T] {
+	return &paginationImplementor[O, T]{
+		service: c,
+		options: o,
+		newPager: func(c cloudantv1.CloudantV1API, o O) (Pager[T], error) {
+			// validate the options with a placeholder partition before discovering partitions
+			if _, err := newPartitionPager(c, withPartition(o, placeholderPartition)); err != nil {
+				return nil, err
+			}
+			pd := &allPartitionsPager[O, R, T]{
+				service: c,
+				options: o,
+				newPartitionImplementor: func(c cloudantv1.CloudantV1API, o O) pagerImplementor[O, R, T] {
+					return newPartitionImplementor(c, o)
+				},
+			}
+			pd.current = pd.newPartitionImplementor(c, withPartition(o, placeholderPartition))
+			return newBasePager(pd), nil
+		},
+	}
+}
+
+// placeholderPartition is the partition key used before the first partition is known.
+const placeholderPartition = "partition"
+
+// allPartitionsPager is a pager implementor chaining the pager implementors of each partition
+// in partition order, discovering the next partition from the _all_docs keys when
+// a partition is exhausted. The pages do not span partitions,
+// the last page of a partition may be partial.
+type allPartitionsPager[O PartitionPagerOptions, R requestResult, T paginatedRow] struct {
+	service                 cloudantv1.CloudantV1API
+	options                 O
+	newPartitionImplementor func(cloudantv1.CloudantV1API, O) pagerImplementor[O, R, T]
+	// current is the implementor of the partition of the next page
+	current   pagerImplementor[O, R, T]
+	partition string
+	started   bool
+	limit     *int64
+	// the items of the last page and the partition after it
+	items         []T
+	err           error
+	next          pagerImplementor[O, R, T]
+	nextPartition string
+	done          bool
+}
+
+func (p *allPartitionsPager[O, R, T]) nextRequestFunction(ctx context.Context) (R, error) {
+	if !p.started {
+		partition, found, err := p.findPartition(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		p.started = true
+		if found {
+			p.usePartition(partition)
+		}
+		// without partitions the placeholder partition returns an empty page
+	}
+	result, err := p.current.nextRequestFunction(ctx)
+	if err != nil {
+		return result, err
+	}
+	if err := validatePagerResponse(result); err != nil {
+		return result, err
+	}
+	p.items, p.err = p.current.itemsGetter(result)
+	if p.err == nil && !p.current.hasNext() && p.partition != "" {
+		// find the next partition now so there is no empty page after the last partition
+		var partition string
+		var found bool
+		partition, found, p.err = p.findPartition(ctx, p.partition)
+		if found {
+			p.next = p.newPartitionImplementor(p.service, withPartition(p.options, partition))
+			p.nextPartition = partition
+		}
+	}
+	p.done = !p.current.hasNext() && p.next == nil
+	return result, nil
+}
+
+func (p *allPartitionsPager[O, R, T]) itemsGetter(R) ([]T, error) {
+	return p.items, p.err
+}
+
+func (p *allPartitionsPager[O, R, T]) hasNext() bool {
+	return !p.done
+}
+
+func (p *allPartitionsPager[O, R, T]) getOptions() O {
+	return p.options
+}
+
+// setOptions restarts the pagination from the first partition with the options.
+func (p *allPartitionsPager[O, R, T]) setOptions(o O) {
+	p.options = o
+	p.current = p.newPartitionImplementor(p.service, withPartition(o, placeholderPartition))
+	p.partition, p.started, p.next, p.done = "", false, nil, false
+}
+
+func (p *allPartitionsPager[O, R, T]) setNextPageOptions(result R) {
+	if p.next != nil {
+		p.current, p.next = p.next, nil
+		p.partition = p.nextPartition
+		if p.limit != nil {
+			p.current.setLimit(*p.limit)
+		}
+		return
+	}
+	p.current.setNextPageOptions(result)
+}
+
+func (p *allPartitionsPager[O, R, T]) getLimit() *int64 {
+	return p.current.getLimit()
+}
+
+func (p *allPartitionsPager[O, R, T]) setLimit(limit int64) {
+	p.limit = &limit
+	p.current.setLimit(limit)
+}
+
+// usePartition makes the implementor of a partition the current implementor.
+func (p *allPartitionsPager[O, R, T]) usePartition(partition string) {
+	p.partition = partition
+	p.current = p.newPartitionImplementor(p.service, withPartition(p.options, partition))
+	if p.limit != nil {
+		p.current.setLimit(*p.limit)
+	}
+}
+
+// Partition returns the partition key of the current page.
+func (p *allPartitionsPager[O, R, T]) Partition() string {
+	return p.partition
+}
+
+// findPartition returns the partition after a partition, or the first partition
+// for an empty partition, from the first _all_docs key after it.
+func (p *allPartitionsPager[O, R, T]) findPartition(ctx context.Context, after string) (string, bool, error) {
+	startKey := ""
+	if after != "" {
+		// ";" sorts right after the ":" separator
+		startKey = after + ";"
+	}
+	for {
+		opts := &cloudantv1.PostAllDocsOptions{Db: core.StringPtr(partitionedDb(p.options))}
+		opts.SetLimit(1)
+		opts.SetStartKey(startKey)
+		result, _, err := p.service.PostAllDocsWithContext(ctx, opts)
+		if err != nil {
+			return "", false, err
+		}
+		if len(result.Rows) == 0 || result.Rows[0].Key == nil {
+			return "", false, nil
+		}
+		key := *result.Rows[0].Key
+		if strings.HasPrefix(key, "_design/") {
+			// "0" sorts right after the "/" of design document IDs
+			startKey = "_design0"
+			continue
+		}
+		partition, _, found := strings.Cut(key, ":")
+		if !found {
+			startKey = key + "\x00"
+			continue
+		}
+		return partition, true, nil
+	}
+}
+
+// partitionedDb returns the database of the options.
+func partitionedDb[O PartitionPagerOptions](o O) string {
+	switch opts := any(o).(type) {
+	case *cloudantv1.PostPartitionAllDocsOptions:
+		return *opts.Db
+	case *cloudantv1.PostPartitionViewOptions:
+		return *opts.Db
+	case *cloudantv1.PostPartitionFindOptions:
+		return *opts.Db
+	case *cloudantv1.PostPartitionSearchOptions:
+		return *opts.Db
+	}
+	return ""
+}
+
+// withPartition returns a copy of the options for the partition.
+func withPartition[O PartitionPagerOptions](o O, partition string) O {
+	switch opts := any(o).(type) {
+	case *cloudantv1.PostPartitionAllDocsOptions:
+		c := *opts
+		c.PartitionKey = core.StringPtr(partition)
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionViewOptions:
+		c := *opts
+		c.PartitionKey = core.StringPtr(partition)
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionFindOptions:
+		c := *opts
+		c.PartitionKey = core.StringPtr(partition)
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionSearchOptions:
+		c := *opts
+		c.PartitionKey = core.StringPtr(partition)
+		return any(&c).(O)
+	}
+	return o
+}
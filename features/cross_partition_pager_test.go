@@ -0,0 +1,176 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newPartitionedServer returns a test server for a partitioned database with sorted document IDs
+func newPartitionedServer(ids []string, allDocsRequests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		w.Header().Set("content-type", "application/json")
+		body, err := readRequestBody(r)
+		Expect(err).ShouldNot(HaveOccurred())
+		q := struct {
+			Limit    *int    `json:"limit"`
+			StartKey *string `json:"start_key"`
+			Bookmark string  `json:"bookmark"`
+		}{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+
+		partition := ""
+		if parts := strings.Split(r.URL.Path, "/"); len(parts) > 3 && parts[2] == "_partition" {
+			partition = parts[3]
+		} else {
+			*allDocsRequests++
+		}
+		rows := make([]map[string]any, 0)
+		for _, id := range ids {
+			if partition != "" && !strings.HasPrefix(id, partition+":") {
+				continue
+			}
+			if q.StartKey != nil && id < *q.StartKey {
+				continue
+			}
+			rows = append(rows, map[string]any{"id": id, "key": id, "value": map[string]any{"rev": "1-a"}})
+		}
+
+		if strings.HasSuffix(r.URL.Path, "/_find") {
+			skip, _ := strconv.Atoi(q.Bookmark)
+			rows = rows[min(skip, len(rows)):]
+			rows = rows[:min(*q.Limit, len(rows))]
+			docs := make([]map[string]any, len(rows))
+			for i, row := range rows {
+				docs[i] = map[string]any{"_id": row["id"]}
+			}
+			bookmark := strconv.Itoa(skip + len(rows))
+			Expect(json.NewEncoder(w).Encode(map[string]any{"docs": docs, "bookmark": bookmark})).To(Succeed())
+			return
+		}
+		if q.Limit != nil {
+			rows = rows[:min(*q.Limit, len(rows))]
+		}
+		Expect(json.NewEncoder(w).Encode(map[string]any{"total_rows": len(ids), "rows": rows})).To(Succeed())
+	}))
+}
+
+var _ = Describe(`All partitions pager tests`, func() {
+	var (
+		server          *httptest.Server
+		service         *cloudantv1.CloudantV1
+		ids             []string
+		allDocsRequests int
+	)
+
+	BeforeEach(func() {
+		ids = []string{"_design/ddoc"}
+		for _, partition := range []string{"apple", "banana", "cherry"} {
+			for i := range 15 {
+				ids = append(ids, fmt.Sprintf("%s:%02d", partition, i))
+			}
+		}
+		// "_design/ddoc" sorts before the partitions in ASCII order
+		allDocsRequests = 0
+		server = newPartitionedServer(ids, &allDocsRequests)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Paginates all docs of all partitions in partition order`, func() {
+		opts := service.NewPostPartitionAllDocsOptions("db", "")
+		opts.SetLimit(10)
+		pagination := NewAllPartitionsAllDocsPagination(service, opts)
+
+		pageSizes := make([]int, 0)
+		scanned := make([]string, 0)
+		for page, err := range pagination.Pages() {
+			Expect(err).ShouldNot(HaveOccurred())
+			pageSizes = append(pageSizes, len(page))
+			for _, row := range page {
+				scanned = append(scanned, *row.ID)
+			}
+		}
+		Expect(scanned).To(Equal(ids[1:]))
+		Expect(pageSizes).To(Equal([]int{10, 5, 10, 5, 10, 5}))
+		// the first partition and each partition after the current partition
+		Expect(allDocsRequests).To(Equal(5))
+	})
+
+	It(`Paginates a view of all partitions`, func() {
+		opts := service.NewPostPartitionViewOptions("db", "", "ddoc", "view")
+		opts.SetLimit(20)
+		rows, err := NewAllPartitionsViewPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		all, err := rows.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(all).To(HaveLen(45))
+		Expect(rows.(*basePager[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow]).pager.(*allPartitionsPager[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow]).Partition()).To(Equal("cherry"))
+	})
+
+	It(`Paginates a query of all partitions`, func() {
+		opts := service.NewPostPartitionFindOptions("db", "", map[string]interface{}{})
+		opts.SetLimit(7)
+		scanned := make([]string, 0)
+		for doc, err := range NewAllPartitionsFindPagination(service, opts).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			scanned = append(scanned, *doc.ID)
+		}
+		Expect(scanned).To(Equal(ids[1:]))
+	})
+
+	It(`Paginates an empty database`, func() {
+		ids = nil
+		server.Close()
+		server = newPartitionedServer(ids, &allDocsRequests)
+		service.SetServiceURL(server.URL)
+		pager, err := NewAllPartitionsSearchPagination(service, service.NewPostPartitionSearchOptions("db", "", "ddoc", "index", "*:*")).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		page, err := pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(BeEmpty())
+		Expect(pager.HasNext()).To(BeFalse())
+		_, err = pager.GetNext()
+		Expect(err).To(MatchError(ErrNoMoreResults))
+	})
+
+	It(`Validates options`, func() {
+		opts := service.NewPostPartitionViewOptions("db", "", "ddoc", "view")
+		opts.SetLimit(1000)
+		_, err := NewAllPartitionsViewPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+	})
+})
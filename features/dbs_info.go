@@ -0,0 +1,201 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultDbsInfoBatchSize is the default number of databases of each PostDbsInfo request.
+const DefaultDbsInfoBatchSize int64 = 100
+
+// PostDbsInfo requests have at most 100 databases.
+var dbsInfoValidationRules = map[string]string{
+	"Limit": fmt.Sprintf("omitempty,min=%d,max=%d", minLimit, DefaultDbsInfoBatchSize),
+}
+
+// DefaultDbsInfoWorkers is the default number of concurrent PostDbsInfo requests.
+const DefaultDbsInfoWorkers int = 4
+
+// DbsInfoOptions configures a DbsInfo.
+type DbsInfoOptions struct {
+	// The number of concurrent PostDbsInfo requests, defaults to DefaultDbsInfoWorkers.
+	Workers int
+}
+
+// DbInfoError is the error for a database without information.
+type DbInfoError struct {
+	// The database name.
+	Db string
+
+	// The error of the database from the PostDbsInfo result.
+	Reason string
+
+	// The error of the PostDbsInfo request for the batch of the database.
+	Err error
+}
+
+func (e *DbInfoError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("failed to get information for database %q: %s", e.Db, e.Err)
+	}
+	return fmt.Sprintf("failed to get information for database %q: %s", e.Db, e.Reason)
+}
+
+func (e *DbInfoError) Unwrap() error {
+	return e.Err
+}
+
+// DbsInfo reads the information of databases from GetAllDbs
+// with batches of databases queried by concurrent PostDbsInfo requests.
+type DbsInfo struct {
+	service cloudantv1.CloudantV1API
+	options *cloudantv1.GetAllDbsOptions
+	workers int
+}
+
+// NewDbsInfo creates a new DbsInfo for the databases of the all databases options.
+// The limit of the options is the number of databases of each PostDbsInfo request,
+// at most and defaulting to DefaultDbsInfoBatchSize.
+func NewDbsInfo(c cloudantv1.CloudantV1API, o *cloudantv1.GetAllDbsOptions, io DbsInfoOptions) (*DbsInfo, error) {
+	if io.Workers < 0 {
+		return nil, fmt.Errorf("the number of workers %d must not be negative", io.Workers)
+	}
+	if err := validatePagerOptions(dbsInfoValidationRules, o); err != nil {
+		return nil, err
+	}
+	opts := *o
+	if opts.Limit == nil {
+		opts.Limit = core.Int64Ptr(DefaultDbsInfoBatchSize)
+	}
+	workers := io.Workers
+	if workers == 0 {
+		workers = DefaultDbsInfoWorkers
+	}
+	return &DbsInfo{
+		service: c,
+		options: &opts,
+		workers: workers,
+	}, nil
+}
+
+// Results returns an iterator for the information of all the databases.
+func (d *DbsInfo) Results() iter.Seq2[cloudantv1.DbsInfoResult, error] {
+	return d.ResultsWithContext(context.Background())
+}
+
+// ResultsWithContext returns an iterator for the information of all the databases
+// queried with user provided context, in the order of GetAllDbs.
+// A database without information has a *DbInfoError and the iteration continues,
+// an error listing the databases stops the iteration.
+func (d *DbsInfo) ResultsWithContext(ctx context.Context) iter.Seq2[cloudantv1.DbsInfoResult, error] {
+	type batch struct {
+		dbs     []string
+		results []cloudantv1.DbsInfoResult
+		err     error
+		listErr error
+	}
+	return func(yield func(cloudantv1.DbsInfoResult, error) bool) {
+		infoCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		// batches are read in order from a channel for each batch
+		batches := make(chan chan batch, d.workers)
+		tokens := make(chan struct{}, d.workers)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(batches)
+			for dbs, err := range NewAllDbsPagination(d.service, d.options).PagesWithContext(infoCtx) {
+				output := make(chan batch, 1)
+				if err != nil {
+					output <- batch{listErr: err}
+					select {
+					case batches <- output:
+					case <-infoCtx.Done():
+					}
+					return
+				}
+				if len(dbs) == 0 {
+					continue
+				}
+				select {
+				case tokens <- struct{}{}:
+				case <-infoCtx.Done():
+					return
+				}
+				select {
+				case batches <- output:
+				case <-infoCtx.Done():
+					<-tokens
+					return
+				}
+				wg.Add(1)
+				go func(dbs []string) {
+					defer wg.Done()
+					defer func() { <-tokens }()
+					results, _, err := d.service.PostDbsInfoWithContext(infoCtx, &cloudantv1.PostDbsInfoOptions{Keys: dbs})
+					output <- batch{dbs: dbs, results: results, err: err}
+				}(dbs)
+			}
+		}()
+
+		for output := range batches {
+			b := <-output
+			if b.listErr != nil {
+				yield(cloudantv1.DbsInfoResult{}, b.listErr)
+				return
+			}
+			if b.err != nil {
+				if err := ctx.Err(); err != nil {
+					yield(cloudantv1.DbsInfoResult{}, err)
+					return
+				}
+				for _, db := range b.dbs {
+					if !yield(cloudantv1.DbsInfoResult{Key: core.StringPtr(db)}, &DbInfoError{Db: db, Err: b.err}) {
+						return
+					}
+				}
+				continue
+			}
+			for _, result := range b.results {
+				var err error
+				if result.Error != nil || result.Info == nil {
+					reason := "no information"
+					if result.Error != nil {
+						reason = *result.Error
+					}
+					err = &DbInfoError{Db: *result.Key, Reason: reason}
+				}
+				if !yield(result, err) {
+					return
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			yield(cloudantv1.DbsInfoResult{}, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newDbsInfoServer returns a test server for _all_dbs and _dbs_info requests,
+// failing _dbs_info requests for a batch with the failBatch database
+func newDbsInfoServer(dbs []string, missing string, failBatch string, maxInFlight *atomic.Int64) *httptest.Server {
+	var inFlight atomic.Int64
+	var mu sync.Mutex
+	allDbs := allDbsHandler(dbs)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		if r.URL.Path == "/_all_dbs" {
+			allDbs(w, r)
+			return
+		}
+		Expect(r.URL.Path).To(Equal("/_dbs_info"))
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		mu.Lock()
+		if current > maxInFlight.Load() {
+			maxInFlight.Store(current)
+		}
+		mu.Unlock()
+
+		w.Header().Set("content-type", "application/json")
+		body, err := readRequestBody(r)
+		if err != nil {
+			// the request was cancelled by a stopped iteration
+			return
+		}
+		q := struct {
+			Keys []string `json:"keys"`
+		}{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+		Expect(len(q.Keys)).To(BeNumerically("<=", 100))
+		if slices.Contains(q.Keys, failBatch) {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":"internal_server_error","reason":"failed"}`)
+			return
+		}
+		results := make([]map[string]any, 0, len(q.Keys))
+		for _, key := range q.Keys {
+			if key == missing {
+				results = append(results, map[string]any{"key": key, "error": "not_found"})
+				continue
+			}
+			results = append(results, map[string]any{"key": key, "info": map[string]any{"db_name": key, "doc_count": len(key)}})
+		}
+		// the write fails if a stopped iteration cancelled the request
+		_ = json.NewEncoder(w).Encode(results)
+	}))
+}
+
+var _ = Describe(`Dbs info tests`, func() {
+	var (
+		server      *httptest.Server
+		service     *cloudantv1.CloudantV1
+		dbs         []string
+		maxInFlight atomic.Int64
+	)
+
+	start := func(missing string, failBatch string) {
+		maxInFlight.Store(0)
+		server = newDbsInfoServer(dbs, missing, failBatch, &maxInFlight)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		dbs = make([]string, 0)
+		for i := range 950 {
+			dbs = append(dbs, fmt.Sprintf("db%04d", i))
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Gets the information of all databases in order`, func() {
+		start("", "")
+		info, err := NewDbsInfo(service, service.NewGetAllDbsOptions(), DbsInfoOptions{Workers: 3})
+		Expect(err).ShouldNot(HaveOccurred())
+		names := make([]string, 0)
+		for result, err := range info.Results() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(*result.Info.DbName).To(Equal(*result.Key))
+			names = append(names, *result.Key)
+		}
+		Expect(names).To(Equal(dbs))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 3))
+	})
+
+	It(`Returns errors for each database and continues`, func() {
+		start("db0010", "db0500")
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(50)
+		info, err := NewDbsInfo(service, opts, DbsInfoOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		count := 0
+		failed := make([]string, 0)
+		for result, err := range info.Results() {
+			count++
+			if err != nil {
+				var dbErr *DbInfoError
+				Expect(errors.As(err, &dbErr)).To(BeTrue())
+				Expect(dbErr.Db).To(Equal(*result.Key))
+				failed = append(failed, dbErr.Db)
+			}
+		}
+		Expect(count).To(Equal(len(dbs)))
+		Expect(failed).To(HaveLen(51))
+		Expect(failed[0]).To(Equal("db0010"))
+		Expect(failed[1:]).To(Equal(dbs[500:550]))
+	})
+
+	It(`Stops on break and cancellation`, func() {
+		start("", "")
+		info, err := NewDbsInfo(service, service.NewGetAllDbsOptions(), DbsInfoOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, err := range info.Results() {
+			Expect(err).ShouldNot(HaveOccurred())
+			break
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var lastErr error
+		count := 0
+		for _, err := range info.ResultsWithContext(ctx) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			count++
+			if count == 10 {
+				cancel()
+			}
+		}
+		Expect(lastErr).To(MatchError(context.Canceled))
+	})
+
+	It(`Validates options`, func() {
+		start("", "")
+		_, err := NewDbsInfo(service, service.NewGetAllDbsOptions(), DbsInfoOptions{Workers: -1})
+		Expect(err).Should(HaveOccurred())
+		opts := service.NewGetAllDbsOptions()
+		opts.SetLimit(500)
+		_, err = NewDbsInfo(service, opts, DbsInfoOptions{})
+		Expect(err).Should(HaveOccurred())
+		opts.SetLimit(101)
+		_, err = NewDbsInfo(service, opts, DbsInfoOptions{})
+		Expect(err).Should(MatchError("the provided limit 101 exceeds the maximum page size value of 100"))
+		opts.SetLimit(100)
+		_, err = NewDbsInfo(service, opts, DbsInfoOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
@@ -26,7 +26,7 @@ type DesignDocsPagerOptions interface {
 }
 
 // NewDesignDocsPagination creates a new pagination for design documents operations.
-func NewDesignDocsPagination[O DesignDocsPagerOptions](c *cloudantv1.CloudantV1, o O) Pagination[cloudantv1.DocsResultRow] {
+func NewDesignDocsPagination[O DesignDocsPagerOptions](c cloudantv1.CloudantV1API, o O) Pagination[cloudantv1.DocsResultRow] {
 	return &paginationImplementor[O, cloudantv1.DocsResultRow]{
 		service:  c,
 		options:  o,
@@ -35,7 +35,7 @@ func NewDesignDocsPagination[O DesignDocsPagerOptions](c *cloudantv1.CloudantV1,
 }
 
 // newDesignDocsPager creates a new pager for design documents operations.
-func newDesignDocsPager[O DesignDocsPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[cloudantv1.DocsResultRow], error) {
+func newDesignDocsPager[O DesignDocsPagerOptions](c cloudantv1.CloudantV1API, o O) (Pager[cloudantv1.DocsResultRow], error) {
 	if err := validatePagerOptions(keyPagerValidationRules, o); err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func newDesignDocsPager[O DesignDocsPagerOptions](c *cloudantv1.CloudantV1, o O)
 		limitSetter: opts.SetLimit,
 		skipSetter:  opts.SetSkip,
 	}
-	p := newBasePager(pd)
+	p := newResumableBasePager(pd)
 
 	return p, nil
 }
@@ -26,7 +26,7 @@ type FindPagerOptions interface {
 }
 
 // NewFindPagination creates a new pagination for queries operations.
-func NewFindPagination[O FindPagerOptions](c *cloudantv1.CloudantV1, o O) Pagination[cloudantv1.Document] {
+func NewFindPagination[O FindPagerOptions](c cloudantv1.CloudantV1API, o O) Pagination[cloudantv1.Document] {
 	return &paginationImplementor[O, cloudantv1.Document]{
 		service:  c,
 		options:  o,
@@ -35,7 +35,7 @@ func NewFindPagination[O FindPagerOptions](c *cloudantv1.CloudantV1, o O) Pagina
 }
 
 // newFindPager creates a new pager for queries operations.
-func newFindPager[O FindPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[cloudantv1.Document], error) {
+func newFindPager[O FindPagerOptions](c cloudantv1.CloudantV1API, o O) (Pager[cloudantv1.Document], error) {
 	if err := validatePagerOptions(bookmarkPagerValidationRules, o); err != nil {
 		return nil, err
 	}
@@ -43,12 +43,12 @@ func newFindPager[O FindPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[clou
 	switch opts := any(o).(type) {
 	case *cloudantv1.PostFindOptions:
 		pd := newFindBookmarkPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	case *cloudantv1.PostPartitionFindOptions:
 		pd := newFindPartitionBookmarkPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	}
@@ -56,7 +56,7 @@ func newFindPager[O FindPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[clou
 	return nil, ErrNotImplemented
 }
 
-func newFindBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostFindOptions) *bookmarkPager[*cloudantv1.PostFindOptions, *cloudantv1.FindResult, cloudantv1.Document] {
+func newFindBookmarkPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostFindOptions) *bookmarkPager[*cloudantv1.PostFindOptions, *cloudantv1.FindResult, cloudantv1.Document] {
 	opts := *o
 	return &bookmarkPager[*cloudantv1.PostFindOptions, *cloudantv1.FindResult, cloudantv1.Document]{
 		service:           c,
@@ -76,7 +76,7 @@ func newFindBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostFindOption
 	}
 }
 
-func newFindPartitionBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostPartitionFindOptions) *bookmarkPager[*cloudantv1.PostPartitionFindOptions, *cloudantv1.FindResult, cloudantv1.Document] {
+func newFindPartitionBookmarkPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionFindOptions) *bookmarkPager[*cloudantv1.PostPartitionFindOptions, *cloudantv1.FindResult, cloudantv1.Document] {
 	opts := *o
 	return &bookmarkPager[*cloudantv1.PostPartitionFindOptions, *cloudantv1.FindResult, cloudantv1.Document]{
 		service:           c,
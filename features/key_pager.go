@@ -18,8 +18,12 @@ package features
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"slices"
 
+	"github.com/IBM/cloudant-go-sdk/base"
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 )
@@ -31,19 +35,19 @@ var keyPagerValidationRules = map[string]string{
 }
 
 type keyPagerOptions interface {
-	AllDocsPagerOptions | DesignDocsPagerOptions | ViewPagerOptions
+	AllDocsPagerOptions | DesignDocsPagerOptions | ViewPagerOptions | *cloudantv1.GetAllDbsOptions
 }
 
 type keyRequestResult interface {
-	*cloudantv1.AllDocsResult | *cloudantv1.ViewResult
+	*cloudantv1.AllDocsResult | *cloudantv1.ViewResult | *allDbsResult
 }
 
 type keyPaginatedRow interface {
-	cloudantv1.DocsResultRow | cloudantv1.ViewResultRow
+	cloudantv1.DocsResultRow | cloudantv1.ViewResultRow | string
 }
 
 type keyPager[O keyPagerOptions, R keyRequestResult, T keyPaginatedRow] struct {
-	service             *cloudantv1.CloudantV1
+	service             cloudantv1.CloudantV1API
 	options             O
 	hasNextPage         bool
 	requestFunction     func(context.Context, O) (R, *core.DetailedResponse, error)
@@ -58,15 +62,71 @@ type keyPager[O keyPagerOptions, R keyRequestResult, T keyPaginatedRow] struct {
 	limitGetter         func() *int64
 	limitSetter         func(int64) O
 	skipSetter          func(int64) O
+	positioned          bool
+	nextStartKey        any
+	nextStartKeyDocID   *string
+	pages               int
+	resumed             bool
+	pageStartKey        any
+	pageStartKeyDocID   *string
+	orderedKeys         bool
+	keysResult          R
+	keys                []any
 }
 
 func (p *keyPager[O, R, T]) nextRequestFunction(ctx context.Context) (R, error) {
-	result, _, err := p.requestFunction(ctx, p.options)
-	return result, err
+	return p.request(ctx, p.options)
+}
+
+// request gets a page. With ordered keys the object keys of the rows are
+// decoded from the raw result in the order of their members, the order of
+// the view collation, instead of the order of the names of the row maps.
+func (p *keyPager[O, R, T]) request(ctx context.Context, opts O) (R, error) {
+	if !p.orderedKeys {
+		result, _, err := p.requestFunction(ctx, opts)
+		return result, err
+	}
+	var raw map[string]json.RawMessage
+	result, _, err := p.requestFunction(base.WithRawResult(ctx, &raw), opts)
+	if err != nil {
+		return result, err
+	}
+	p.keysResult, p.keys = result, nil
+	var rows []struct {
+		Key json.RawMessage `json:"key"`
+	}
+	// a service without raw results, for example a mock, keeps the keys of the rows
+	if raw == nil || json.Unmarshal(raw["rows"], &rows) != nil || len(rows) != len(p.resultItemsGetter(result)) {
+		return result, nil
+	}
+	keys := make([]any, 0, len(rows))
+	for _, row := range rows {
+		key, err := base.DecodeOrderedKey(row.Key)
+		if err != nil {
+			return result, nil
+		}
+		keys = append(keys, key)
+	}
+	p.keys = keys
+	return result, nil
+}
+
+// resultKey returns the key of the item of a result at an index.
+func (p *keyPager[O, R, T]) resultKey(result R, i int) any {
+	if p.keys != nil && result == p.keysResult {
+		return p.keys[i]
+	}
+	key, _ := p.itemKey(p.resultItemsGetter(result)[i])
+	return key
 }
 
 func (p *keyPager[O, R, T]) itemsGetter(result R) ([]T, error) {
 	items := p.resultItemsGetter(result)
+	p.pages++
+	if len(items) > 0 {
+		_, p.pageStartKeyDocID = p.itemKey(items[0])
+		p.pageStartKey = p.resultKey(result, 0)
+	}
 	if p.limitGetter() != nil && len(items) < int(*p.limitGetter()) {
 		p.hasNextPage = false
 		return items, nil
@@ -79,9 +139,9 @@ func (p *keyPager[O, R, T]) itemsGetter(result R) ([]T, error) {
 		penultimateItem := items[itemsNum-1]
 		lID := p.startKeyDocIDGetter(lastItem)
 		pID := p.startKeyDocIDGetter(penultimateItem)
-		lKey := p.startViewKeyGetter(lastItem)
-		pKey := p.startViewKeyGetter(penultimateItem)
-		if lID == pID && pKey == lKey {
+		lKey := p.resultKey(result, itemsNum)
+		pKey := p.resultKey(result, itemsNum-1)
+		if lID == pID && reflect.DeepEqual(pKey, lKey) {
 			err = fmt.Errorf("cannot paginate on a boundary containing identical keys %q and document IDs %q", lKey, lID)
 		}
 	}
@@ -101,18 +161,69 @@ func (p *keyPager[O, R, T]) setNextPageOptions(result R) {
 	}
 	itemsNum := len(items) - 1
 	lastItem := items[itemsNum]
+	p.positioned = true
 	if p.startKeySetter != nil {
 		startKey := p.startKeyGetter(lastItem)
 		p.startKeySetter(startKey)
+		p.nextStartKey = startKey
 	}
 	if p.startViewKeySetter != nil {
-		startViewKey := p.startViewKeyGetter(lastItem)
+		startViewKey := p.resultKey(result, itemsNum)
 		p.startViewKeySetter(startViewKey)
+		p.nextStartKey = startViewKey
 	}
 	if p.startKeyDocIDSetter != nil {
 		startKeyDocID := p.startKeyDocIDGetter(lastItem)
 		p.startKeyDocIDSetter(startKeyDocID)
+		p.nextStartKeyDocID = &startKeyDocID
+	}
+}
+
+func (p *keyPager[O, R, T]) getPosition() (pagerPosition, error) {
+	position := pagerPosition{Done: !p.hasNextPage}
+	if !p.positioned || position.Done {
+		return position, nil
+	}
+	startKey, err := json.Marshal(p.nextStartKey)
+	if err != nil {
+		return position, err
 	}
+	position.StartKey = startKey
+	position.StartKeyDocID = p.nextStartKeyDocID
+	return position, nil
+}
+
+func (p *keyPager[O, R, T]) setPosition(position pagerPosition) error {
+	p.hasNextPage = !position.Done
+	if position.StartKey == nil {
+		return nil
+	}
+	startKey, err := decodeStartKey(position.StartKey)
+	if err != nil {
+		return err
+	}
+	p.skipSetter(0)
+	p.positioned = true
+	p.resumed = true
+	p.nextStartKey = startKey
+	if p.startKeySetter != nil {
+		key, ok := startKey.(string)
+		if !ok {
+			return fmt.Errorf("%w: the start key %v is not a string", ErrInvalidPagerToken, startKey)
+		}
+		p.startKeySetter(key)
+	}
+	if p.startViewKeySetter != nil {
+		// the raw key keeps the order of the members of objects
+		rawKey := slices.Clone(position.StartKey)
+		p.nextStartKey = rawKey
+		p.startViewKeySetter(rawKey)
+	}
+	if p.startKeyDocIDSetter != nil && position.StartKeyDocID != nil {
+		p.startKeyDocIDSetter(*position.StartKeyDocID)
+		p.nextStartKeyDocID = position.StartKeyDocID
+	}
+	return nil
 }
 
 func (p *keyPager[O, R, T]) getOptions() O {
@@ -130,3 +241,144 @@ func (p *keyPager[O, R, T]) getLimit() *int64 {
 func (p *keyPager[O, R, T]) setLimit(pageSize int64) {
 	p.limitSetter(pageSize + 1)
 }
+
+func (p *keyPager[O, R, T]) hasPrevious() bool {
+	return p.pages > 1
+}
+
+// getPrevious retrieves the page before the current page by reading
+// in the opposite direction from the start of the current page.
+func (p *keyPager[O, R, T]) getPrevious(ctx context.Context, initial O, pageSize int64) ([]T, error) {
+	// the first page is read again with the initial options
+	// to keep any skip, unless the pager was resumed from a token
+	firstPage := p.pages == 2 && !p.resumed
+	var opts O
+	if firstPage {
+		opts = previousKeyPageOptions(initial, pageSize+1, nil, nil)
+	} else {
+		opts = previousKeyPageOptions(initial, pageSize, p.pageStartKey, p.pageStartKeyDocID)
+	}
+	result, err := p.request(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePagerResponse(result); err != nil {
+		return nil, err
+	}
+
+	items := slices.Clone(p.resultItemsGetter(result))
+	first := 0
+	if !firstPage {
+		first = len(items) - 1
+	}
+	if firstPage {
+		if len(items) > int(pageSize) {
+			items = items[:pageSize]
+		}
+	} else {
+		slices.Reverse(items)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("the previous page is empty")
+	}
+
+	// the next page starts at the start of the current page
+	p.skipSetter(0)
+	p.positioned = true
+	p.nextStartKey = p.pageStartKey
+	p.nextStartKeyDocID = p.pageStartKeyDocID
+	if p.startKeySetter != nil {
+		p.startKeySetter(p.pageStartKey.(string))
+	}
+	if p.startViewKeySetter != nil {
+		p.startViewKeySetter(p.pageStartKey)
+	}
+	if p.startKeyDocIDSetter != nil && p.pageStartKeyDocID != nil {
+		p.startKeyDocIDSetter(*p.pageStartKeyDocID)
+	}
+	p.hasNextPage = true
+	p.pages--
+	_, p.pageStartKeyDocID = p.itemKey(items[0])
+	p.pageStartKey = p.resultKey(result, first)
+	return items, nil
+}
+
+// itemKey returns the key and document ID of an item.
+func (p *keyPager[O, R, T]) itemKey(item T) (any, *string) {
+	var key any
+	var docID *string
+	if p.startKeyGetter != nil {
+		key = p.startKeyGetter(item)
+	}
+	if p.startViewKeyGetter != nil {
+		key = p.startViewKeyGetter(item)
+	}
+	if p.startKeyDocIDGetter != nil {
+		id := p.startKeyDocIDGetter(item)
+		docID = &id
+	}
+	return key, docID
+}
+
+// previousKeyPageOptions returns a copy of the options with the limit.
+// With a start key the copy reads in the opposite direction from the start key,
+// excluding it, up to the initial start key.
+func previousKeyPageOptions[O keyPagerOptions](o O, limit int64, startKey any, startKeyDocID *string) O {
+	reverse := func(descending *bool) *bool {
+		return core.BoolPtr(descending == nil || !*descending)
+	}
+	switch opts := any(o).(type) {
+	case *cloudantv1.PostAllDocsOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if key, ok := startKey.(string); ok {
+			c.StartKey, c.EndKey = &key, opts.StartKey
+			c.Descending, c.InclusiveEnd, c.Skip = reverse(opts.Descending), core.BoolPtr(true), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionAllDocsOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if key, ok := startKey.(string); ok {
+			c.StartKey, c.EndKey = &key, opts.StartKey
+			c.Descending, c.InclusiveEnd, c.Skip = reverse(opts.Descending), core.BoolPtr(true), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	case *cloudantv1.GetAllDbsOptions:
+		// the end key of _all_dbs is always inclusive
+		c := *opts
+		c.SetLimit(limit)
+		if key, ok := startKey.(string); ok {
+			c.StartKey, c.EndKey = &key, opts.StartKey
+			c.Descending, c.Skip = reverse(opts.Descending), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostDesignDocsOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if key, ok := startKey.(string); ok {
+			c.StartKey, c.EndKey = &key, opts.StartKey
+			c.Descending, c.InclusiveEnd, c.Skip = reverse(opts.Descending), core.BoolPtr(true), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostViewOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if startKey != nil {
+			c.StartKey, c.EndKey = startKey, opts.StartKey
+			c.StartKeyDocID, c.EndKeyDocID = startKeyDocID, opts.StartKeyDocID
+			c.Descending, c.InclusiveEnd, c.Skip = reverse(opts.Descending), core.BoolPtr(true), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	case *cloudantv1.PostPartitionViewOptions:
+		c := *opts
+		c.SetLimit(limit)
+		if startKey != nil {
+			c.StartKey, c.EndKey = startKey, opts.StartKey
+			c.StartKeyDocID, c.EndKeyDocID = startKeyDocID, opts.StartKeyDocID
+			c.Descending, c.InclusiveEnd, c.Skip = reverse(opts.Descending), core.BoolPtr(true), core.Int64Ptr(1)
+		}
+		return any(&c).(O)
+	}
+	return o
+}
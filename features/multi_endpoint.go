@@ -0,0 +1,518 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"golang.org/x/net/publicsuffix"
+)
+
+// DefaultProbeInterval is the default period between endpoint health probes.
+const DefaultProbeInterval time.Duration = 30 * time.Second
+
+// DefaultErrorWindow is the default number of recent requests
+// used to calculate an endpoint's error rate.
+const DefaultErrorWindow int = 20
+
+// DefaultMaxErrorRate is the default error rate at which
+// an endpoint is considered unhealthy.
+const DefaultMaxErrorRate float64 = 0.5
+
+// HealthProbe are enums for the request used to probe endpoint health.
+type HealthProbe int
+
+const (
+	// HeadUpProbe probes endpoints with HeadUpInformation
+	HeadUpProbe HealthProbe = iota
+	// GetUpProbe probes endpoints with GetUpInformation
+	// and requires the "ok" status
+	GetUpProbe
+)
+
+// Endpoint is a service URL and the authenticator used for it.
+type Endpoint struct {
+	// [Required] The service URL of the endpoint.
+	URL string
+
+	// [Required] The authenticator for requests to the endpoint.
+	Authenticator core.Authenticator
+}
+
+// MultiEndpointOptions configures a MultiEndpointClient.
+type MultiEndpointOptions struct {
+	// [Required] The endpoints in order of preference.
+	Endpoints []Endpoint
+
+	// The request used to probe endpoint health, defaults to HeadUpProbe.
+	Probe HealthProbe
+
+	// The period between health probes, defaults to DefaultProbeInterval.
+	ProbeInterval time.Duration
+
+	// The number of recent requests used to calculate the error rate,
+	// defaults to DefaultErrorWindow.
+	ErrorWindow int
+
+	// The error rate at which an endpoint is considered unhealthy,
+	// defaults to DefaultMaxErrorRate.
+	MaxErrorRate float64
+
+	// Fail over the requests of write operations too,
+	// defaults to false. A write that failed with a connection error
+	// or a 5xx status code may already have been applied by the server,
+	// so failing it over may apply it twice.
+	FailoverWrites bool
+}
+
+// MultiEndpointClient is a Cloudant client that routes each request
+// to the most preferred healthy endpoint.
+//
+// GET and HEAD requests, and the POST requests of read operations such as
+// PostFind, PostAllDocs, PostView, PostSearch and PostChanges, failing with
+// a connection error or a 5xx status code are retried on the next endpoint,
+// other requests only when FailoverWrites is set.
+// Request bodies of requests that may fail over are buffered in memory
+// unless they can be replayed already.
+// An endpoint is considered unhealthy after a connection error or when
+// its error rate over the recent requests reaches the maximum error rate.
+// Endpoints are probed in the background. A successful probe makes an
+// unreachable endpoint reachable again and counts as a successful request,
+// so requests fail back to a preferred endpoint once its error rate recovers.
+//
+// Each endpoint keeps its own authenticator and session cookies.
+// The embedded CloudantV1 client must not have its HTTP client,
+// authenticator or service URL replaced, as they implement the routing.
+type MultiEndpointClient struct {
+	*cloudantv1.CloudantV1
+	endpoints      []*endpoint
+	probe          HealthProbe
+	interval       time.Duration
+	failoverWrites bool
+	next           http.RoundTripper
+	primary        *neturl.URL
+	ctx            context.Context
+	cancel         context.CancelFunc
+	logger         core.Logger
+}
+
+// endpoint keeps the client and health state of a single endpoint.
+type endpoint struct {
+	url       *neturl.URL
+	service   *cloudantv1.CloudantV1
+	jar       http.CookieJar
+	window    int
+	maxRate   float64
+	reachable bool
+	results   []bool
+	errors    int
+	nextIndex int
+	mu        sync.Mutex
+}
+
+// NewMultiEndpointClient returns a new MultiEndpointClient or an error if
+// provided configuration is invalid. Health probes run until Stop() is called.
+func NewMultiEndpointClient(o *MultiEndpointOptions) (*MultiEndpointClient, error) {
+	return NewMultiEndpointClientWithContext(context.Background(), o)
+}
+
+// NewMultiEndpointClientWithContext returns a new MultiEndpointClient
+// with health probes running until the context is done or Stop() is called.
+func NewMultiEndpointClientWithContext(ctx context.Context, o *MultiEndpointOptions) (*MultiEndpointClient, error) {
+	if o == nil || len(o.Endpoints) == 0 {
+		return nil, core.SDKErrorf(nil, "at least one endpoint must be configured", "multi-endpoint-no-endpoints", common.GetComponentInfo())
+	}
+	if o.MaxErrorRate < 0 || o.MaxErrorRate > 1 {
+		err := fmt.Errorf("the maximum error rate %v is not between 0 and 1", o.MaxErrorRate)
+		return nil, core.SDKErrorf(err, "", "multi-endpoint-invalid-rate", common.GetComponentInfo())
+	}
+
+	mc := &MultiEndpointClient{
+		probe:          o.Probe,
+		interval:       o.ProbeInterval,
+		failoverWrites: o.FailoverWrites,
+		next:           http.DefaultTransport,
+		logger:         core.GetLogger(),
+	}
+	if mc.interval <= 0 {
+		mc.interval = DefaultProbeInterval
+	}
+	window := o.ErrorWindow
+	if window <= 0 {
+		window = DefaultErrorWindow
+	}
+	maxRate := o.MaxErrorRate
+	if maxRate == 0 {
+		maxRate = DefaultMaxErrorRate
+	}
+
+	for _, e := range o.Endpoints {
+		ep, err := mc.newEndpoint(e, window, maxRate)
+		if err != nil {
+			return nil, err
+		}
+		mc.endpoints = append(mc.endpoints, ep)
+	}
+	mc.primary = mc.endpoints[0].url
+
+	service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+		URL:           o.Endpoints[0].URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := service.Service.GetHTTPClient()
+	client.Transport = mc
+	service.Service.SetHTTPClient(client)
+	// cookies are kept per endpoint
+	client.Jar = nil
+	mc.CloudantV1 = service
+
+	mc.ctx, mc.cancel = context.WithCancel(ctx)
+	go mc.probeLoop()
+	return mc, nil
+}
+
+// newEndpoint creates the per-endpoint client used for
+// authentication and health probes.
+func (mc *MultiEndpointClient) newEndpoint(e Endpoint, window int, maxRate float64) (*endpoint, error) {
+	if e.Authenticator == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf(core.ERRORMSG_PROP_MISSING, "Authenticator"), "multi-endpoint-no-auth", common.GetComponentInfo())
+	}
+	service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+		URL:           e.URL,
+		Authenticator: e.Authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	u, err := neturl.Parse(service.GetServiceURL())
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "multi-endpoint-invalid-url", common.GetComponentInfo())
+	}
+
+	// we can ignore the error, jar.New it is actually always returns nil
+	jar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	client := core.DefaultHTTPClient()
+	client.Timeout = service.Service.GetHTTPClient().Timeout
+	client.Transport = mc.next
+	client.Jar = jar
+	service.Service.SetHTTPClient(client)
+
+	return &endpoint{
+		url:       u,
+		service:   service,
+		jar:       jar,
+		window:    window,
+		maxRate:   maxRate,
+		reachable: true,
+		results:   make([]bool, 0, window),
+	}, nil
+}
+
+// SetServiceURL is not supported, the endpoint URLs are fixed
+// when the MultiEndpointClient is created.
+func (mc *MultiEndpointClient) SetServiceURL(url string) error {
+	return core.SDKErrorf(nil, "the service URL of a MultiEndpointClient cannot be changed", "multi-endpoint-set-url", common.GetComponentInfo())
+}
+
+// Stop the background health probes.
+func (mc *MultiEndpointClient) Stop() {
+	mc.cancel()
+}
+
+// ActiveEndpoint returns the URL of the endpoint that receives requests.
+func (mc *MultiEndpointClient) ActiveEndpoint() string {
+	return mc.route()[0].url.String()
+}
+
+// HealthyEndpoints returns the URLs of the endpoints currently considered healthy.
+func (mc *MultiEndpointClient) HealthyEndpoints() []string {
+	var urls []string
+	for _, ep := range mc.endpoints {
+		if ep.isHealthy() {
+			urls = append(urls, ep.url.String())
+		}
+	}
+	return urls
+}
+
+// CheckHealth probes all endpoints and updates their health.
+func (mc *MultiEndpointClient) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, ep := range mc.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			err := mc.probeEndpoint(ctx, ep)
+			if err != nil {
+				mc.logger.Debug("Endpoint %s probe failed: %s", ep.url, err)
+			}
+			ep.probed(err == nil)
+		}(ep)
+	}
+	wg.Wait()
+}
+
+// probeLoop periodically probes endpoint health until the client is stopped.
+func (mc *MultiEndpointClient) probeLoop() {
+	ticker := time.NewTicker(mc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mc.ctx.Done():
+			return
+		case <-ticker.C:
+			mc.CheckHealth(mc.ctx)
+		}
+	}
+}
+
+// probeEndpoint runs a single health probe against an endpoint.
+func (mc *MultiEndpointClient) probeEndpoint(ctx context.Context, ep *endpoint) error {
+	switch mc.probe {
+	case GetUpProbe:
+		result, _, err := ep.service.GetUpInformationWithContext(ctx, ep.service.NewGetUpInformationOptions())
+		if err != nil {
+			return err
+		}
+		if result.Status == nil || *result.Status != cloudantv1.UpInformationStatusOkConst {
+			return fmt.Errorf("endpoint status is not %q", cloudantv1.UpInformationStatusOkConst)
+		}
+		return nil
+	default:
+		_, err := ep.service.HeadUpInformationWithContext(ctx, ep.service.NewHeadUpInformationOptions())
+		return err
+	}
+}
+
+// route returns the endpoints in the order requests should try them:
+// healthy endpoints by preference followed by unhealthy ones.
+func (mc *MultiEndpointClient) route() []*endpoint {
+	healthy := make([]*endpoint, 0, len(mc.endpoints))
+	var unhealthy []*endpoint
+	for _, ep := range mc.endpoints {
+		if ep.isHealthy() {
+			healthy = append(healthy, ep)
+		} else {
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// RoundTrip implements RoundTripper interface
+func (mc *MultiEndpointClient) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoints := mc.route()
+	if !mc.canFailover(req) {
+		endpoints = endpoints[:1]
+	} else if len(endpoints) > 1 {
+		if err := bufferBody(req); err != nil {
+			return nil, err
+		}
+	}
+	var resp *http.Response
+	var err error
+	for i, ep := range endpoints {
+		if i > 0 {
+			if resp != nil {
+				_, _ = io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+			mc.logger.Debug("Failing over %s %s to %s", req.Method, req.URL.Path, ep.url)
+		}
+		resp, err = mc.roundTripEndpoint(req, ep, i > 0)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			ep.record(true)
+			return resp, nil
+		}
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			// connection errors take the endpoint out of rotation immediately
+			ep.setReachable(false)
+		}
+		ep.record(false)
+	}
+	return resp, err
+}
+
+// readOperationIds are the operations that read with a POST request,
+// so they fail over like GET and HEAD requests.
+var readOperationIds = []string{
+	"PostAllDocs", "PostAllDocsAsStream", "PostAllDocsQueries", "PostAllDocsQueriesAsStream",
+	"PostBulkGet", "PostBulkGetAsMixed", "PostBulkGetAsRelated", "PostBulkGetAsStream",
+	"PostChanges", "PostChangesAsStream", "PostDbsInfo", "PostDesignDocs", "PostDesignDocsQueries",
+	"PostExplain", "PostFind", "PostFindAsStream",
+	"PostPartitionAllDocs", "PostPartitionAllDocsAsStream", "PostPartitionExplain",
+	"PostPartitionFind", "PostPartitionFindAsStream", "PostPartitionSearch", "PostPartitionSearchAsStream",
+	"PostPartitionView", "PostPartitionViewAsStream", "PostRevsDiff",
+	"PostSearch", "PostSearchAnalyze", "PostSearchAsStream",
+	"PostView", "PostViewAsStream", "PostViewQueries", "PostViewQueriesAsStream",
+}
+
+// canFailover reports whether a failed request may be retried on another endpoint.
+func (mc *MultiEndpointClient) canFailover(req *http.Request) bool {
+	if mc.failoverWrites || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	return req.Method == http.MethodPost && slices.Contains(readOperationIds, operationID(req))
+}
+
+// operationID returns the operation ID of the analytics header of a request.
+func operationID(req *http.Request) string {
+	// the service sets the header without canonicalizing its name
+	for name, values := range req.Header {
+		if !strings.EqualFold(name, "X-IBMCloud-SDK-Analytics") || len(values) == 0 {
+			continue
+		}
+		for _, field := range strings.Split(values[0], ";") {
+			if id, ok := strings.CutPrefix(field, "operation_id="); ok {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// bufferBody reads a request body that cannot be replayed into memory
+// so the request can be retried on another endpoint.
+func bufferBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body.Close()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+// roundTripEndpoint sends a request to the given endpoint using
+// the endpoint's authenticator and cookies.
+func (mc *MultiEndpointClient) roundTripEndpoint(req *http.Request, ep *endpoint, replay bool) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	if replay && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		out.Body = body
+	}
+	out.URL = ep.rewrite(req.URL, mc.primary)
+	out.Host = ""
+	out.Header.Del("Authorization")
+	out.Header.Del("Cookie")
+
+	if err := ep.service.Service.Options.Authenticator.Authenticate(out); err != nil {
+		return nil, err
+	}
+	for _, cookie := range ep.jar.Cookies(out.URL) {
+		out.AddCookie(cookie)
+	}
+
+	resp, err := mc.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	if cookies := resp.Cookies(); len(cookies) > 0 {
+		ep.jar.SetCookies(out.URL, cookies)
+	}
+	resp.Request = req
+	return resp, nil
+}
+
+// rewrite replaces the primary endpoint's scheme, host and path prefix
+// of a request URL with the endpoint's ones.
+func (ep *endpoint) rewrite(u *neturl.URL, primary *neturl.URL) *neturl.URL {
+	out := *u
+	out.Scheme = ep.url.Scheme
+	out.Host = ep.url.Host
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(primary.Path, "/"))
+	out.Path = strings.TrimSuffix(ep.url.Path, "/") + path
+	if u.RawPath != "" {
+		rawPath := strings.TrimPrefix(u.RawPath, strings.TrimSuffix(primary.EscapedPath(), "/"))
+		out.RawPath = strings.TrimSuffix(ep.url.EscapedPath(), "/") + rawPath
+	}
+	return &out
+}
+
+// isHealthy reports whether the endpoint is reachable and its error rate
+// is below the maximum, after at least half of the window was filled.
+func (ep *endpoint) isHealthy() bool {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if !ep.reachable {
+		return false
+	}
+	return len(ep.results)*2 < ep.window ||
+		float64(ep.errors)/float64(len(ep.results)) < ep.maxRate
+}
+
+func (ep *endpoint) setReachable(reachable bool) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	ep.reachable = reachable
+}
+
+// probed updates the endpoint with a health probe outcome.
+// A successful probe also counts towards the error window, so an endpoint
+// made unhealthy by its error rate recovers gradually instead of flapping.
+func (ep *endpoint) probed(success bool) {
+	ep.setReachable(success)
+	if success {
+		ep.record(true)
+	}
+}
+
+// record adds a request outcome to the error window.
+func (ep *endpoint) record(success bool) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if len(ep.results) < ep.window {
+		ep.results = append(ep.results, success)
+	} else {
+		if !ep.results[ep.nextIndex] {
+			ep.errors--
+		}
+		ep.results[ep.nextIndex] = success
+		ep.nextIndex = (ep.nextIndex + 1) % ep.window
+	}
+	if !success {
+		ep.errors++
+	}
+}
@@ -0,0 +1,262 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// regionServer is a test server recording requests and failing on demand
+type regionServer struct {
+	*httptest.Server
+	name     string
+	down     atomic.Bool
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   []string
+}
+
+func newRegionServer(name string) *regionServer {
+	rs := &regionServer{name: name}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := readRequestBody(r)
+		Expect(err).ShouldNot(HaveOccurred())
+		rs.mu.Lock()
+		rs.requests = append(rs.requests, r)
+		rs.bodies = append(rs.bodies, body)
+		rs.mu.Unlock()
+		w.Header().Set("content-type", "application/json")
+		if rs.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":"service_unavailable","reason":"down"}`)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/_up") {
+			fmt.Fprint(w, `{"status":"ok"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"couchdb":"Welcome","vendor":{"name":%q},"version":"3","features":[]}`, rs.name)
+	}))
+	return rs
+}
+
+// readRequestBody reads a possibly gzip compressed request body
+func readRequestBody(r *http.Request) (string, error) {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return "", err
+		}
+		reader = gz
+	}
+	body, err := io.ReadAll(reader)
+	return string(body), err
+}
+
+func (rs *regionServer) requestCount() int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return len(rs.requests)
+}
+
+func (rs *regionServer) lastRequest() *http.Request {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.requests[len(rs.requests)-1]
+}
+
+var _ = Describe(`MultiEndpointClient tests`, func() {
+	var (
+		east, west *regionServer
+		client     *MultiEndpointClient
+	)
+
+	vendor := func() string {
+		result, _, err := client.GetServerInformation(client.NewGetServerInformationOptions())
+		Expect(err).ShouldNot(HaveOccurred())
+		return *result.Vendor.Name
+	}
+
+	BeforeEach(func() {
+		east = newRegionServer("east")
+		west = newRegionServer("west")
+
+		eastAuth, err := core.NewBasicAuthenticator("east-user", "east-pass")
+		Expect(err).ShouldNot(HaveOccurred())
+		westAuth, err := core.NewBasicAuthenticator("west-user", "west-pass")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		client, err = NewMultiEndpointClient(&MultiEndpointOptions{
+			Endpoints: []Endpoint{
+				{URL: east.URL, Authenticator: eastAuth},
+				{URL: west.URL + "/proxy", Authenticator: westAuth},
+			},
+			Probe:         GetUpProbe,
+			ProbeInterval: time.Hour,
+			ErrorWindow:   4,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Stop()
+		east.Close()
+		west.Close()
+	})
+
+	It(`Validates options`, func() {
+		_, err := NewMultiEndpointClient(&MultiEndpointOptions{})
+		Expect(err).Should(HaveOccurred())
+
+		_, err = NewMultiEndpointClient(&MultiEndpointOptions{Endpoints: []Endpoint{{URL: east.URL}}})
+		Expect(err).Should(HaveOccurred())
+
+		_, err = NewMultiEndpointClient(&MultiEndpointOptions{
+			Endpoints:    []Endpoint{{URL: east.URL, Authenticator: &core.NoAuthAuthenticator{}}},
+			MaxErrorRate: 2,
+		})
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It(`Routes requests to the preferred endpoint with its authenticator`, func() {
+		Expect(vendor()).To(Equal("east"))
+		Expect(client.ActiveEndpoint()).To(Equal(east.URL))
+
+		user, _, ok := east.lastRequest().BasicAuth()
+		Expect(ok).To(BeTrue())
+		Expect(user).To(Equal("east-user"))
+		Expect(west.requestCount()).To(Equal(0))
+	})
+
+	It(`Fails over on 5xx and fails back once probes restore the error rate`, func() {
+		east.down.Store(true)
+
+		Expect(vendor()).To(Equal("west"))
+		req := west.lastRequest()
+		Expect(req.URL.Path).To(Equal("/proxy/"))
+		user, _, _ := req.BasicAuth()
+		Expect(user).To(Equal("west-user"))
+
+		// the error rate over half of the window makes east unhealthy
+		Expect(vendor()).To(Equal("west"))
+		Expect(client.HealthyEndpoints()).To(Equal([]string{west.URL + "/proxy"}))
+		eastRequests := east.requestCount()
+		Expect(vendor()).To(Equal("west"))
+		Expect(east.requestCount()).To(Equal(eastRequests))
+
+		client.CheckHealth(context.Background())
+		Expect(client.ActiveEndpoint()).To(Equal(west.URL + "/proxy"))
+
+		// successful probes count towards the error window
+		east.down.Store(false)
+		client.CheckHealth(context.Background())
+		client.CheckHealth(context.Background())
+		Expect(client.ActiveEndpoint()).To(Equal(west.URL + "/proxy"))
+		client.CheckHealth(context.Background())
+		Expect(client.HealthyEndpoints()).To(HaveLen(2))
+		Expect(vendor()).To(Equal("east"))
+	})
+
+	It(`Fails over on connection errors`, func() {
+		east.Close()
+
+		Expect(vendor()).To(Equal("west"))
+		Expect(client.HealthyEndpoints()).To(Equal([]string{west.URL + "/proxy"}))
+	})
+
+	It(`Returns the last error when all endpoints fail`, func() {
+		east.down.Store(true)
+		west.down.Store(true)
+
+		_, response, err := client.GetServerInformation(client.NewGetServerInformationOptions())
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(east.requestCount()).To(Equal(1))
+		Expect(west.requestCount()).To(Equal(1))
+	})
+
+	It(`Fails over the POST requests of read operations`, func() {
+		east.down.Store(true)
+
+		_, _, err := client.PostFind(client.NewPostFindOptions("db", map[string]any{"type": "a"}))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(east.requestCount()).To(Equal(1))
+		Expect(west.lastRequest().URL.Path).To(Equal("/proxy/db/_find"))
+		Expect(west.bodies[len(west.bodies)-1]).To(Equal(east.bodies[0]))
+		Expect(east.bodies[0]).To(ContainSubstring(`"selector"`))
+
+		_, _, err = client.PostAllDocs(client.NewPostAllDocsOptions("db"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(west.lastRequest().URL.Path).To(Equal("/proxy/db/_all_docs"))
+	})
+
+	It(`Does not fail over writes by default`, func() {
+		east.down.Store(true)
+
+		_, response, err := client.PostDocument(client.NewPostDocumentOptions("db").SetDocument(&cloudantv1.Document{}))
+		Expect(err).Should(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(east.requestCount()).To(Equal(1))
+		Expect(west.requestCount()).To(Equal(0))
+	})
+
+	It(`Replays request bodies on failover of writes when enabled`, func() {
+		client.Stop()
+		var err error
+		client, err = NewMultiEndpointClient(&MultiEndpointOptions{
+			Endpoints: []Endpoint{
+				{URL: east.URL, Authenticator: &core.NoAuthAuthenticator{}},
+				{URL: west.URL + "/proxy", Authenticator: &core.NoAuthAuthenticator{}},
+			},
+			ProbeInterval:  time.Hour,
+			FailoverWrites: true,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		east.down.Store(true)
+
+		document := &cloudantv1.Document{}
+		document.SetProperty("type", "a")
+		_, _, err = client.PostDocument(client.NewPostDocumentOptions("db").SetDocument(document))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(west.lastRequest().URL.Path).To(Equal("/proxy/db"))
+		Expect(west.bodies[len(west.bodies)-1]).To(Equal(east.bodies[0]))
+		Expect(east.bodies[0]).ToNot(BeEmpty())
+	})
+
+	It(`Embeds a usable CloudantV1 client`, func() {
+		var service *cloudantv1.CloudantV1 = client.CloudantV1
+		Expect(service.GetServiceURL()).To(Equal(east.URL))
+		Expect(client.SetServiceURL(west.URL)).ShouldNot(Succeed())
+		Expect(client.GetServiceURL()).To(Equal(east.URL))
+	})
+})
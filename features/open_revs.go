@@ -0,0 +1,271 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// OpenRevsOptions configures the documents of GetDocumentOpenRevs and GetDocumentAttsSince.
+type OpenRevsOptions struct {
+	// Include the content of attachments in the documents.
+	Attachments bool
+
+	// Include the encoding information of compressed attachments.
+	AttEncodingInfo bool
+
+	// Get the latest leaf revision of the revisions instead of the revisions.
+	Latest bool
+
+	// Include the revision history of the documents.
+	Revs bool
+
+	// Get the attachments as multipart/mixed parts instead of base64 encoded data.
+	Multipart bool
+}
+
+// DocumentRevision is a revision of a document with either the document or the error of the revision.
+type DocumentRevision struct {
+	// The revision of the document.
+	Rev string
+
+	// The document of the revision.
+	Document *cloudantv1.Document
+
+	// The *RevisionError of the revision.
+	Err error
+}
+
+// RevisionError is the error of a revision of a document from the PostBulkGet result.
+type RevisionError struct {
+	// The document ID.
+	ID string
+
+	// The revision.
+	Rev string
+
+	// The error of the revision, for example not_found.
+	Code string
+
+	// The reason of the error.
+	Reason string
+}
+
+func (e *RevisionError) Error() string {
+	return fmt.Sprintf("failed to get revision %q of document %q: %s: %s", e.Rev, e.ID, e.Code, e.Reason)
+}
+
+// GetDocumentOpenRevs gets the revisions of a document, the equivalent of GetDocument with open_revs.
+// With nil or empty revs it gets all leaf revisions of the document.
+// The revisions are read with a PostBulkGet request, a revision that
+// cannot be read has a *RevisionError instead of a document.
+func GetDocumentOpenRevs(ctx context.Context, c cloudantv1.CloudantV1API, db, docID string, revs []string, o OpenRevsOptions) ([]DocumentRevision, error) {
+	if docID == "" {
+		return nil, errors.New("the document ID must not be empty")
+	}
+	docs := make([]cloudantv1.BulkGetQueryDocument, 0, max(len(revs), 1))
+	if len(revs) == 0 {
+		docs = append(docs, cloudantv1.BulkGetQueryDocument{ID: &docID})
+	}
+	for _, rev := range revs {
+		docs = append(docs, cloudantv1.BulkGetQueryDocument{ID: &docID, Rev: &rev})
+	}
+	return getDocumentRevisions(ctx, c, db, docs, o)
+}
+
+// GetDocumentAttsSince gets a revision of a document, the equivalent of GetDocument with atts_since.
+// The document includes the content of the attachments changed since the attsSince revisions,
+// the other attachments are stubs. With an empty rev it gets the winning revision of the document.
+// A revision that cannot be read returns a *RevisionError.
+func GetDocumentAttsSince(ctx context.Context, c cloudantv1.CloudantV1API, db, docID, rev string, attsSince []string, o OpenRevsOptions) (*cloudantv1.Document, error) {
+	if docID == "" {
+		return nil, errors.New("the document ID must not be empty")
+	}
+	doc := cloudantv1.BulkGetQueryDocument{ID: &docID, AttsSince: attsSince}
+	if rev != "" {
+		doc.Rev = &rev
+	}
+	o.Attachments = true
+	revisions, err := getDocumentRevisions(ctx, c, db, []cloudantv1.BulkGetQueryDocument{doc}, o)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision of document %q in the result", docID)
+	}
+	return revisions[0].Document, revisions[0].Err
+}
+
+// getDocumentRevisions makes the PostBulkGet request of the documents
+// and unwraps the revisions of the result.
+func getDocumentRevisions(ctx context.Context, c cloudantv1.CloudantV1API, db string, docs []cloudantv1.BulkGetQueryDocument, o OpenRevsOptions) ([]DocumentRevision, error) {
+	opts := &cloudantv1.PostBulkGetOptions{Db: core.StringPtr(db), Docs: docs}
+	if o.Attachments {
+		opts.SetAttachments(true)
+	}
+	if o.AttEncodingInfo {
+		opts.SetAttEncodingInfo(true)
+	}
+	if o.Latest {
+		opts.SetLatest(true)
+	}
+	if o.Revs {
+		opts.SetRevs(true)
+	}
+
+	if o.Multipart {
+		body, response, err := c.PostBulkGetAsMixedWithContext(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return readMultipartRevisions(body, response.GetHeaders().Get("Content-Type"))
+	}
+
+	result, _, err := c.PostBulkGetWithContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]DocumentRevision, 0)
+	for _, item := range result.Results {
+		for _, doc := range item.Docs {
+			if doc.Ok != nil {
+				revisions = append(revisions, DocumentRevision{Rev: stringValue(doc.Ok.Rev), Document: doc.Ok})
+				continue
+			}
+			revisions = append(revisions, errorRevision(stringValue(item.ID), doc.Error))
+		}
+	}
+	return revisions, nil
+}
+
+// readMultipartRevisions reads the revisions of a multipart/mixed PostBulkGet response,
+// with a multipart/related part of the document and the attachments of each revision
+// with attachments and an application/json part of each other revision.
+func readMultipartRevisions(body io.Reader, contentType string) ([]DocumentRevision, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type of the multipart response: %w", err)
+	}
+	if mediaType != "multipart/mixed" {
+		return nil, fmt.Errorf("unexpected content type %q of the multipart response", mediaType)
+	}
+	revisions := make([]DocumentRevision, 0)
+	mr := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return revisions, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the multipart response: %w", err)
+		}
+		mediaType, params, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid content type of the multipart response part: %w", err)
+		}
+		var revision DocumentRevision
+		switch mediaType {
+		case "multipart/related":
+			revision, err = readRelatedRevision(multipart.NewReader(part, params["boundary"]))
+		case "application/json":
+			revision, err = readJSONRevision(part)
+		default:
+			err = fmt.Errorf("unexpected content type %q of the multipart response part", mediaType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+}
+
+// readRelatedRevision reads the document of the first part of a multipart/related revision
+// and sets the data of its attachments from the following parts.
+func readRelatedRevision(mr *multipart.Reader) (DocumentRevision, error) {
+	part, err := mr.NextPart()
+	if err != nil {
+		return DocumentRevision{}, fmt.Errorf("failed to read the document part: %w", err)
+	}
+	revision, err := readJSONRevision(part)
+	if err != nil || revision.Document == nil {
+		return revision, err
+	}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return revision, nil
+		}
+		if err != nil {
+			return DocumentRevision{}, fmt.Errorf("failed to read the attachment part: %w", err)
+		}
+		name := part.FileName()
+		att, ok := revision.Document.Attachments[name]
+		if !ok {
+			return DocumentRevision{}, fmt.Errorf("unexpected attachment %q of document %q", name, stringValue(revision.Document.ID))
+		}
+		data, err := io.ReadAll(part)
+		if err != nil {
+			return DocumentRevision{}, fmt.Errorf("failed to read attachment %q: %w", name, err)
+		}
+		att.Data = &data
+		att.Follows = nil
+		revision.Document.Attachments[name] = att
+	}
+}
+
+// readJSONRevision reads the document or the error of a revision.
+func readJSONRevision(r io.Reader) (DocumentRevision, error) {
+	m := make(map[string]json.RawMessage)
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return DocumentRevision{}, fmt.Errorf("failed to decode the revision: %w", err)
+	}
+	if _, ok := m["error"]; ok {
+		var result *cloudantv1.DocumentResult
+		if err := cloudantv1.UnmarshalDocumentResult(m, &result); err != nil {
+			return DocumentRevision{}, err
+		}
+		return errorRevision("", result), nil
+	}
+	var doc *cloudantv1.Document
+	if err := cloudantv1.UnmarshalDocument(m, &doc); err != nil {
+		return DocumentRevision{}, err
+	}
+	return DocumentRevision{Rev: stringValue(doc.Rev), Document: doc}, nil
+}
+
+func errorRevision(docID string, result *cloudantv1.DocumentResult) DocumentRevision {
+	err := &RevisionError{ID: docID}
+	if result != nil {
+		if result.ID != nil {
+			err.ID = *result.ID
+		}
+		err.Rev = stringValue(result.Rev)
+		err.Code = stringValue(result.Error)
+		err.Reason = stringValue(result.Reason)
+	}
+	return DocumentRevision{Rev: err.Rev, Err: err}
+}
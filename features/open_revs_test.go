@@ -0,0 +1,219 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newBulkGetServer returns a test server for PostBulkGet requests of a document
+// with the leaf revisions 2-b and 2-c, the attachment of 2-b changed at revision 2
+func newBulkGetServer(requests *[]map[string]any) *httptest.Server {
+	revisions := map[string]map[string]any{
+		"2-b": {"_id": "doc", "_rev": "2-b", "value": "b"},
+		"2-c": {"_id": "doc", "_rev": "2-c", "value": "c"},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		Expect(r.URL.Path).To(Equal("/db/_bulk_get"))
+		body, err := readRequestBody(r)
+		Expect(err).ShouldNot(HaveOccurred())
+		q := struct {
+			Docs []struct {
+				ID        string   `json:"id"`
+				Rev       string   `json:"rev"`
+				AttsSince []string `json:"atts_since"`
+			} `json:"docs"`
+		}{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+		*requests = append(*requests, map[string]any{"body": body, "query": r.URL.RawQuery})
+
+		results := make([]map[string]any, 0)
+		for _, d := range q.Docs {
+			revs := []string{"2-b", "2-c"}
+			if d.Rev != "" {
+				revs = []string{d.Rev}
+			} else if d.ID != "doc" {
+				revs = []string{"undefined"}
+			}
+			for _, rev := range revs {
+				doc, ok := revisions[rev]
+				if !ok || d.ID != "doc" {
+					results = append(results, map[string]any{"error": map[string]any{
+						"id": d.ID, "rev": rev, "error": "not_found", "reason": "missing",
+					}})
+					continue
+				}
+				doc = map[string]any{"_id": doc["_id"], "_rev": doc["_rev"], "value": doc["value"]}
+				if rev == "2-b" {
+					att := map[string]any{"content_type": "text/plain", "revpos": 2, "length": 5}
+					if len(d.AttsSince) > 0 && d.AttsSince[0] == "2-b" {
+						att["stub"] = true
+					} else if r.Header.Get("Accept") == "multipart/mixed" {
+						att["follows"] = true
+					} else {
+						att["data"] = base64.StdEncoding.EncodeToString([]byte("hello"))
+					}
+					doc["_attachments"] = map[string]any{"a.txt": att}
+				}
+				results = append(results, map[string]any{"ok": doc})
+			}
+		}
+
+		if r.Header.Get("Accept") != "multipart/mixed" {
+			w.Header().Set("content-type", "application/json")
+			Expect(json.NewEncoder(w).Encode(map[string]any{
+				"results": []map[string]any{{"id": "doc", "docs": results}},
+			})).To(Succeed())
+			return
+		}
+		mw := multipart.NewWriter(w)
+		w.Header().Set("content-type", "multipart/mixed; boundary="+mw.Boundary())
+		for _, result := range results {
+			if e, ok := result["error"]; ok {
+				part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {`application/json; error="true"`}})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(json.NewEncoder(part).Encode(e)).To(Succeed())
+				continue
+			}
+			doc := result["ok"].(map[string]any)
+			atts, ok := doc["_attachments"].(map[string]any)
+			if !ok || atts["a.txt"].(map[string]any)["follows"] == nil {
+				part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json"}})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(json.NewEncoder(part).Encode(doc)).To(Succeed())
+				continue
+			}
+			var related bytes.Buffer
+			rw := multipart.NewWriter(&related)
+			part, err := rw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(json.NewEncoder(part).Encode(doc)).To(Succeed())
+			part, err = rw.CreatePart(textproto.MIMEHeader{
+				"Content-Disposition": {`attachment; filename="a.txt"`},
+				"Content-Type":        {"text/plain"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = part.Write([]byte("hello"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rw.Close()).To(Succeed())
+			part, err = mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"multipart/related; boundary=" + rw.Boundary()}})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = part.Write(related.Bytes())
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(mw.Close()).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Open revisions tests`, func() {
+	var (
+		server   *httptest.Server
+		service  *cloudantv1.CloudantV1
+		requests []map[string]any
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		server = newBulkGetServer(&requests)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Gets all leaf revisions`, func() {
+		revisions, err := GetDocumentOpenRevs(context.Background(), service, "db", "doc", nil, OpenRevsOptions{Attachments: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[0].Rev).To(Equal("2-b"))
+		Expect(revisions[0].Err).ShouldNot(HaveOccurred())
+		Expect(*revisions[0].Document.Attachments["a.txt"].Data).To(Equal([]byte("hello")))
+		Expect(revisions[1].Rev).To(Equal("2-c"))
+		Expect(revisions[1].Document.GetProperty("value")).To(Equal("c"))
+		Expect(requests[0]["body"]).To(MatchJSON(`{"docs":[{"id":"doc"}]}`))
+		Expect(requests[0]["query"]).To(Equal("attachments=true"))
+	})
+
+	It(`Gets revisions with errors`, func() {
+		revisions, err := GetDocumentOpenRevs(context.Background(), service, "db", "doc", []string{"2-c", "3-x"}, OpenRevsOptions{Latest: true, Revs: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(revisions).To(HaveLen(2))
+		Expect(*revisions[0].Document.Rev).To(Equal("2-c"))
+		Expect(revisions[1].Document).To(BeNil())
+		Expect(revisions[1].Rev).To(Equal("3-x"))
+		var revErr *RevisionError
+		Expect(revisions[1].Err).To(BeAssignableToTypeOf(revErr))
+		Expect(revisions[1].Err).To(MatchError(`failed to get revision "3-x" of document "doc": not_found: missing`))
+		Expect(requests[0]["body"]).To(MatchJSON(`{"docs":[{"id":"doc","rev":"2-c"},{"id":"doc","rev":"3-x"}]}`))
+		Expect(requests[0]["query"]).To(Equal("latest=true&revs=true"))
+	})
+
+	It(`Gets multipart revisions`, func() {
+		revisions, err := GetDocumentOpenRevs(context.Background(), service, "db", "doc", []string{"2-b", "2-c", "3-x"}, OpenRevsOptions{Attachments: true, Multipart: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(revisions).To(HaveLen(3))
+		att := revisions[0].Document.Attachments["a.txt"]
+		Expect(*att.Data).To(Equal([]byte("hello")))
+		Expect(att.Follows).To(BeNil())
+		Expect(*att.ContentType).To(Equal("text/plain"))
+		Expect(revisions[1].Document.Attachments).To(BeEmpty())
+		Expect(revisions[2].Err).To(MatchError(ContainSubstring("not_found")))
+	})
+
+	It(`Gets attachments since revisions`, func() {
+		doc, err := GetDocumentAttsSince(context.Background(), service, "db", "doc", "2-b", []string{"1-a"}, OpenRevsOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Attachments["a.txt"].Data).To(Equal([]byte("hello")))
+		Expect(requests[0]["body"]).To(MatchJSON(`{"docs":[{"id":"doc","rev":"2-b","atts_since":["1-a"]}]}`))
+		Expect(requests[0]["query"]).To(Equal("attachments=true"))
+
+		doc, err = GetDocumentAttsSince(context.Background(), service, "db", "doc", "2-b", []string{"2-b"}, OpenRevsOptions{Multipart: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*doc.Attachments["a.txt"].Stub).To(BeTrue())
+		Expect(doc.Attachments["a.txt"].Data).To(BeNil())
+
+		_, err = GetDocumentAttsSince(context.Background(), service, "db", "missing", "", []string{"1-a"}, OpenRevsOptions{})
+		Expect(err).To(MatchError(ContainSubstring(`document "missing"`)))
+	})
+
+	It(`Validates the document ID`, func() {
+		_, err := GetDocumentOpenRevs(context.Background(), service, "db", "", nil, OpenRevsOptions{})
+		Expect(err).Should(HaveOccurred())
+		_, err = GetDocumentAttsSince(context.Background(), service, "db", "", "", nil, OpenRevsOptions{})
+		Expect(err).Should(HaveOccurred())
+		Expect(requests).To(BeEmpty())
+	})
+})
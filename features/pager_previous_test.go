@@ -0,0 +1,181 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// runPreviousAssertion pages forward to the end, back to the start
+// and forward again checking the pages are the same in both directions
+func runPreviousAssertion[T PaginatedRow](p Pagination[T], expectPages int) {
+	p0, err := p.Pager()
+	Expect(err).ShouldNot(HaveOccurred())
+	pager, ok := p0.(BidirectionalPager[T])
+	Expect(ok).To(BeTrue())
+	Expect(pager.HasPrevious()).To(BeFalse())
+	_, err = pager.GetPrevious()
+	Expect(err).To(MatchError(ErrNoPreviousResults))
+
+	pages := make([][]T, 0)
+	for pager.HasNext() {
+		page, err := pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		pages = append(pages, page)
+	}
+	Expect(pages).To(HaveLen(expectPages))
+
+	for i := len(pages) - 2; i >= 0; i-- {
+		Expect(pager.HasPrevious()).To(BeTrue())
+		page, err := pager.GetPrevious()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(Equal(pages[i]))
+	}
+	Expect(pager.HasPrevious()).To(BeFalse())
+
+	Expect(pager.HasNext()).To(BeTrue())
+	for i := 1; i < len(pages); i++ {
+		page, err := pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(Equal(pages[i]))
+	}
+}
+
+var _ = Describe(`Previous page tests`, func() {
+	var (
+		ms      *mockService
+		server  *httptest.Server
+		service *cloudantv1.CloudantV1
+	)
+
+	expectItems := 3*defaultTestPageSize + 1
+
+	BeforeEach(func() {
+		ms = newMockService()
+		ms.makeItems(expectItems)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			mockServerCallback(w, r, ms)
+		}))
+
+		var serviceErr error
+		service, serviceErr = cloudantv1.NewCloudantV1(
+			&cloudantv1.CloudantV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			},
+		)
+		Expect(serviceErr).ShouldNot(HaveOccurred())
+		service.SetEnableGzipCompression(false)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Gets previous pages of all docs`, func() {
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runPreviousAssertion(NewAllDocsPagination(service, opts), 4)
+	})
+
+	It(`Gets previous pages of all docs with skip`, func() {
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		opts.SetSkip(2)
+		runPreviousAssertion(NewAllDocsPagination(service, opts), 3)
+	})
+
+	It(`Gets previous pages of design docs`, func() {
+		opts := service.NewPostDesignDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runPreviousAssertion(NewDesignDocsPagination(service, opts), 4)
+	})
+
+	It(`Gets previous pages of a view`, func() {
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runPreviousAssertion(NewViewPagination(service, opts), 4)
+	})
+
+	It(`Gets previous pages of a query`, func() {
+		opts := service.NewPostFindOptions("db", map[string]interface{}{})
+		opts.SetLimit(int64(defaultTestPageSize))
+		runPreviousAssertion(NewFindPagination(service, opts), 4)
+	})
+
+	It(`Gets previous pages of a search`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runPreviousAssertion(NewSearchPagination(service, opts), 4)
+	})
+
+	It(`Gets previous pages of a view resumed from a token`, func() {
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetLimit(int64(defaultTestPageSize))
+		p0, err := NewViewPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		first, err := p0.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		token, err := p0.(TokenPager[cloudantv1.ViewResultRow]).Token(tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		resumed, err := NewViewPaginationFromToken(service, opts, token, tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		p0, err = resumed.Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		pager := p0.(BidirectionalPager[cloudantv1.ViewResultRow])
+		second, err := pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		page, err := pager.GetPrevious()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(Equal(second))
+		Expect(page).ToNot(Equal(first))
+
+		// pages before the token are not in the history
+		Expect(pager.HasPrevious()).To(BeFalse())
+	})
+
+	It(`Limits the bookmark history`, func() {
+		ms.items = nil
+		ms.makeItems((BookmarkHistorySize + 2) * defaultTestPageSize)
+		opts := service.NewPostFindOptions("db", map[string]interface{}{})
+		opts.SetLimit(int64(defaultTestPageSize))
+		p0, err := NewFindPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		pager := p0.(BidirectionalPager[cloudantv1.Document])
+		for range BookmarkHistorySize + 2 {
+			_, err := pager.GetNext()
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		for range BookmarkHistorySize {
+			_, err := pager.GetPrevious()
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(pager.HasPrevious()).To(BeFalse())
+	})
+})
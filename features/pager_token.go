@@ -0,0 +1,200 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// pagerTokenVersion is the version of the pager token format.
+const pagerTokenVersion = "v1"
+
+// ErrInvalidPagerToken is wrapped by errors for tokens that cannot be used
+// to resume pagination, because they are malformed, were modified,
+// were signed with a different key or were created for different options.
+var ErrInvalidPagerToken = errors.New("invalid pager token")
+
+// pagerPosition is the position of a pager before its next page.
+type pagerPosition struct {
+	// Done is true when there are no more pages.
+	Done bool `json:"e,omitempty"`
+
+	// StartKey is the key of the next page for key pagers.
+	StartKey json.RawMessage `json:"k,omitempty"`
+
+	// StartKeyDocID is the document ID of the next page for view pagers.
+	StartKeyDocID *string `json:"d,omitempty"`
+
+	// Bookmark is the bookmark of the next page for bookmark pagers.
+	Bookmark *string `json:"b,omitempty"`
+}
+
+// pagerToken is the signed content of a token.
+type pagerToken struct {
+	// Options is a fingerprint of the pagination options.
+	Options string `json:"o"`
+
+	pagerPosition
+}
+
+// positionedPager is implemented by pagers that can save and restore their position.
+type positionedPager interface {
+	getPosition() (pagerPosition, error)
+	setPosition(pagerPosition) error
+}
+
+// NewAllDocsPaginationFromToken creates a new pagination for all documents operations
+// resuming from a token returned by Pager.Token for the same options.
+func NewAllDocsPaginationFromToken[O AllDocsPagerOptions](c cloudantv1.CloudantV1API, o O, token string, key []byte) (Pagination[cloudantv1.DocsResultRow], error) {
+	return newPaginationFromToken(c, o, newAllDocsPager[O], token, key)
+}
+
+// NewDesignDocsPaginationFromToken creates a new pagination for design documents operations
+// resuming from a token returned by Pager.Token for the same options.
+func NewDesignDocsPaginationFromToken[O DesignDocsPagerOptions](c cloudantv1.CloudantV1API, o O, token string, key []byte) (Pagination[cloudantv1.DocsResultRow], error) {
+	return newPaginationFromToken(c, o, newDesignDocsPager[O], token, key)
+}
+
+// NewViewPaginationFromToken creates a new pagination for views operations
+// resuming from a token returned by Pager.Token for the same options.
+func NewViewPaginationFromToken[O ViewPagerOptions](c cloudantv1.CloudantV1API, o O, token string, key []byte) (Pagination[cloudantv1.ViewResultRow], error) {
+	return newPaginationFromToken(c, o, newViewPager[O], token, key)
+}
+
+// NewFindPaginationFromToken creates a new pagination for queries operations
+// resuming from a token returned by Pager.Token for the same options.
+func NewFindPaginationFromToken[O FindPagerOptions](c cloudantv1.CloudantV1API, o O, token string, key []byte) (Pagination[cloudantv1.Document], error) {
+	return newPaginationFromToken(c, o, newFindPager[O], token, key)
+}
+
+// NewSearchPaginationFromToken creates a new pagination for searches operations
+// resuming from a token returned by Pager.Token for the same options.
+func NewSearchPaginationFromToken[O SearchPagerOptions](c cloudantv1.CloudantV1API, o O, token string, key []byte) (Pagination[cloudantv1.SearchResultRow], error) {
+	return newPaginationFromToken(c, o, newSearchPager[O], token, key)
+}
+
+// newPaginationFromToken verifies the token and creates a pagination
+// with pagers starting at the position from the token.
+func newPaginationFromToken[O pagerOptions, T paginatedRow](c cloudantv1.CloudantV1API, o O, newPager func(cloudantv1.CloudantV1API, O) (Pager[T], error), token string, key []byte) (Pagination[T], error) {
+	position, err := decodePagerToken(o, token, key)
+	if err != nil {
+		return nil, err
+	}
+	return &paginationImplementor[O, T]{
+		service:  c,
+		options:  o,
+		newPager: newPager,
+		position: position,
+	}, nil
+}
+
+// encodePagerToken signs the position of a pager for the options.
+// The token has the form version.payload.signature.
+func encodePagerToken[O pagerOptions](o O, position pagerPosition, key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("a key is required to sign pager tokens")
+	}
+	fingerprint, err := optionsFingerprint(o)
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(pagerToken{Options: fingerprint, pagerPosition: position})
+	if err != nil {
+		return "", err
+	}
+	signed := pagerTokenVersion + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signPagerToken(signed, key)), nil
+}
+
+// decodePagerToken verifies a token and returns its position.
+func decodePagerToken[O pagerOptions](o O, token string, key []byte) (*pagerPosition, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("a key is required to verify pager tokens")
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidPagerToken)
+	}
+	if parts[0] != pagerTokenVersion {
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidPagerToken, parts[0])
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, signPagerToken(parts[0]+"."+parts[1], key)) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidPagerToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPagerToken, err)
+	}
+	var t pagerToken
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPagerToken, err)
+	}
+	fingerprint, err := optionsFingerprint(o)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(t.Options), []byte(fingerprint)) {
+		return nil, fmt.Errorf("%w: the token was created for different options", ErrInvalidPagerToken)
+	}
+	return &t.pagerPosition, nil
+}
+
+func signPagerToken(signed string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// optionsFingerprint returns a hash of the options without the headers.
+func optionsFingerprint[O pagerOptions](o O) (string, error) {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return "", err
+	}
+	delete(m, "Headers")
+	// maps are marshalled with sorted keys
+	b, err = json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// decodeStartKey decodes a start key keeping the precision of numbers.
+func decodeStartKey(raw json.RawMessage) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var key any
+	err := decoder.Decode(&key)
+	return key, err
+}
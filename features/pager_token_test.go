@@ -0,0 +1,230 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var tokenKey = []byte("test-token-key")
+
+// runTokenResumeAssertion reads the first page, resumes from a token
+// and checks the resumed pagination returns all the other items
+func runTokenResumeAssertion[T PaginatedRow](p Pagination[T], resume func(string) (Pagination[T], error), expectItems int) {
+	all := make([]T, 0)
+	for row, err := range p.Rows() {
+		Expect(err).ShouldNot(HaveOccurred())
+		all = append(all, row)
+	}
+	Expect(all).To(HaveLen(expectItems))
+
+	p0, err := p.Pager()
+	Expect(err).ShouldNot(HaveOccurred())
+	pager, ok := p0.(TokenPager[T])
+	Expect(ok).To(BeTrue())
+	first, err := pager.GetNext()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(first).To(HaveLen(defaultTestPageSize))
+	token, err := pager.Token(tokenKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	resumed, err := resume(token)
+	Expect(err).ShouldNot(HaveOccurred())
+	rest := make([]T, 0)
+	for row, err := range resumed.Rows() {
+		Expect(err).ShouldNot(HaveOccurred())
+		rest = append(rest, row)
+	}
+	Expect(append(first, rest...)).To(Equal(all))
+
+	// a token for an exhausted pager resumes with no more pages
+	_, err = pager.GetAll()
+	Expect(err).ShouldNot(HaveOccurred())
+	token, err = pager.Token(tokenKey)
+	Expect(err).ShouldNot(HaveOccurred())
+	resumed, err = resume(token)
+	Expect(err).ShouldNot(HaveOccurred())
+	resumedPager, err := resumed.Pager()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(resumedPager.HasNext()).To(BeFalse())
+}
+
+var _ = Describe(`Pager token tests`, func() {
+	var (
+		ms      *mockService
+		server  *httptest.Server
+		service *cloudantv1.CloudantV1
+	)
+
+	expectItems := 3*defaultTestPageSize + 1
+
+	BeforeEach(func() {
+		ms = newMockService()
+		ms.makeItems(expectItems)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			mockServerCallback(w, r, ms)
+		}))
+
+		var serviceErr error
+		service, serviceErr = cloudantv1.NewCloudantV1(
+			&cloudantv1.CloudantV1Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			},
+		)
+		Expect(serviceErr).ShouldNot(HaveOccurred())
+		service.SetEnableGzipCompression(false)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Resumes all docs pagination from a token`, func() {
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runTokenResumeAssertion(NewAllDocsPagination(service, opts), func(token string) (Pagination[cloudantv1.DocsResultRow], error) {
+			return NewAllDocsPaginationFromToken(service, opts, token, tokenKey)
+		}, expectItems)
+	})
+
+	It(`Resumes design docs pagination from a token`, func() {
+		opts := service.NewPostDesignDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runTokenResumeAssertion(NewDesignDocsPagination(service, opts), func(token string) (Pagination[cloudantv1.DocsResultRow], error) {
+			return NewDesignDocsPaginationFromToken(service, opts, token, tokenKey)
+		}, expectItems)
+	})
+
+	It(`Resumes view pagination from a token`, func() {
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runTokenResumeAssertion(NewViewPagination(service, opts), func(token string) (Pagination[cloudantv1.ViewResultRow], error) {
+			return NewViewPaginationFromToken(service, opts, token, tokenKey)
+		}, expectItems)
+	})
+
+	It(`Resumes find pagination from a token`, func() {
+		opts := service.NewPostFindOptions("db", map[string]interface{}{})
+		opts.SetLimit(int64(defaultTestPageSize))
+		runTokenResumeAssertion(NewFindPagination(service, opts), func(token string) (Pagination[cloudantv1.Document], error) {
+			return NewFindPaginationFromToken(service, opts, token, tokenKey)
+		}, expectItems)
+	})
+
+	It(`Resumes search pagination from a token`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetLimit(int64(defaultTestPageSize))
+		runTokenResumeAssertion(NewSearchPagination(service, opts), func(token string) (Pagination[cloudantv1.SearchResultRow], error) {
+			return NewSearchPaginationFromToken(service, opts, token, tokenKey)
+		}, expectItems)
+	})
+
+	It(`Resumes from a token created before the first page`, func() {
+		opts := service.NewPostFindOptions("db", map[string]interface{}{})
+		opts.SetLimit(int64(defaultTestPageSize))
+		pager, err := NewFindPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		token, err := pager.(TokenPager[cloudantv1.Document]).Token(tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		resumed, err := NewFindPaginationFromToken(service, opts, token, tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		runRowsAssertion(resumed, expectItems)
+	})
+
+	It(`Keeps view keys of any type`, func() {
+		pd := newViewKeyPager(service, service.NewPostViewOptions("db", "ddoc", "view"))
+		pd.positioned = true
+		pd.nextStartKey = []any{"a", 12345678901234567}
+		docID := "doc1"
+		pd.nextStartKeyDocID = &docID
+		position, err := pd.getPosition()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		resumed := newViewKeyPager(service, service.NewPostViewOptions("db", "ddoc", "view"))
+		Expect(resumed.setPosition(position)).To(Succeed())
+		startKey, err := json.Marshal(resumed.options.StartKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(startKey).To(MatchJSON(`["a", 12345678901234567]`))
+		Expect(*resumed.options.StartKeyDocID).To(Equal("doc1"))
+		Expect(*resumed.options.Skip).To(BeZero())
+	})
+
+	It(`Does not resume skip and seq pagers from tokens`, func() {
+		scheduler, err := NewSchedulerDocsPagination(service, service.NewGetSchedulerDocsOptions()).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, ok := scheduler.(TokenPager[cloudantv1.SchedulerDocument])
+		Expect(ok).To(BeFalse())
+		_, ok = scheduler.(BidirectionalPager[cloudantv1.SchedulerDocument])
+		Expect(ok).To(BeFalse())
+
+		changes, err := NewChangesPagination(service, service.NewPostChangesOptions("db")).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, ok = changes.(TokenPager[cloudantv1.ChangesResultItem])
+		Expect(ok).To(BeFalse())
+	})
+
+	It(`Rejects invalid tokens`, func() {
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(int64(defaultTestPageSize))
+		p0, err := NewAllDocsPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		pager := p0.(TokenPager[cloudantv1.DocsResultRow])
+		_, err = pager.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		token, err := pager.Token(tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(token).To(HavePrefix("v1."))
+
+		_, err = pager.Token(nil)
+		Expect(err).Should(HaveOccurred())
+
+		_, err = NewAllDocsPaginationFromToken(service, opts, token, []byte("other-key"))
+		Expect(err).To(MatchError(ErrInvalidPagerToken))
+
+		parts := strings.Split(token, ".")
+		_, err = NewAllDocsPaginationFromToken(service, opts, "v2."+parts[1]+"."+parts[2], tokenKey)
+		Expect(err).To(MatchError(ErrInvalidPagerToken))
+
+		_, err = NewAllDocsPaginationFromToken(service, opts, parts[0]+"."+parts[1]+"x."+parts[2], tokenKey)
+		Expect(err).To(MatchError(ErrInvalidPagerToken))
+
+		_, err = NewAllDocsPaginationFromToken(service, opts, "not-a-token", tokenKey)
+		Expect(err).To(MatchError(ErrInvalidPagerToken))
+
+		other := service.NewPostAllDocsOptions("other-db")
+		other.SetLimit(int64(defaultTestPageSize))
+		_, err = NewAllDocsPaginationFromToken(service, other, token, tokenKey)
+		Expect(err).To(MatchError(ErrInvalidPagerToken))
+
+		// headers do not change the options fingerprint
+		opts.SetHeaders(map[string]string{"X-Request-Id": "1"})
+		_, err = NewAllDocsPaginationFromToken(service, opts, token, tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
@@ -38,16 +38,34 @@ type Pagination[T PaginatedRow] interface {
 
 	// RowsWithContext returns an iterator for all elements from the pager queried with user provided context.
 	RowsWithContext(context.Context) iter.Seq2[T, error]
+
+	// WithPrefetch returns a copy of the pagination with iterators fetching
+	// up to pages pages ahead of the consumer in the background.
+	// A number of pages lower than 1 disables prefetching.
+	WithPrefetch(pages int) Pagination[T]
 }
 
 type paginationImplementor[O pagerOptions, T paginatedRow] struct {
-	service  *cloudantv1.CloudantV1
+	service  cloudantv1.CloudantV1API
 	options  O
-	newPager func(*cloudantv1.CloudantV1, O) (Pager[T], error)
+	newPager func(cloudantv1.CloudantV1API, O) (Pager[T], error)
+	position *pagerPosition
+	prefetch int
 }
 
 func (pi *paginationImplementor[O, T]) Pager() (Pager[T], error) {
-	return pi.newPager(pi.service, pi.options)
+	pager, err := pi.newPager(pi.service, pi.options)
+	if err != nil || pi.position == nil {
+		return pager, err
+	}
+	p, ok := pager.(positionedPager)
+	if !ok {
+		return nil, ErrNotImplemented
+	}
+	if err := p.setPosition(*pi.position); err != nil {
+		return nil, err
+	}
+	return pager, nil
 }
 
 func (pi *paginationImplementor[O, T]) Pages() iter.Seq2[[]T, error] {
@@ -61,6 +79,9 @@ func (pi *paginationImplementor[O, T]) PagesWithContext(ctx context.Context) ite
 			yield(nil, err)
 		}
 	}
+	if pi.prefetch > 0 {
+		return prefetchPagesWithContext(ctx, pager, pi.prefetch)
+	}
 	return pagesWithContext(ctx, pager)
 }
 
@@ -75,9 +96,18 @@ func (pi *paginationImplementor[O, T]) RowsWithContext(ctx context.Context) iter
 			yield(*new(T), err)
 		}
 	}
+	if pi.prefetch > 0 {
+		return rowsFromPages(prefetchPagesWithContext(ctx, pager, pi.prefetch))
+	}
 	return rowsWithContext(ctx, pager)
 }
 
+func (pi *paginationImplementor[O, T]) WithPrefetch(pages int) Pagination[T] {
+	p := *pi
+	p.prefetch = max(pages, 0)
+	return &p
+}
+
 // pagesWithContext returns an iterator for all pages from the pager queried with user provided context.
 func pagesWithContext[T PaginatedRow](ctx context.Context, pd Pager[T]) iter.Seq2[[]T, error] {
 	return func(yield func([]T, error) bool) {
@@ -94,10 +124,68 @@ func pagesWithContext[T PaginatedRow](ctx context.Context, pd Pager[T]) iter.Seq
 	}
 }
 
+// prefetchPagesWithContext returns an iterator for all pages from the pager
+// fetching up to prefetch pages ahead of the consumer in a background goroutine.
+func prefetchPagesWithContext[T PaginatedRow](ctx context.Context, pd Pager[T], prefetch int) iter.Seq2[[]T, error] {
+	type page struct {
+		rows []T
+		err  error
+	}
+	return func(yield func([]T, error) bool) {
+		fetchCtx, cancel := context.WithCancel(ctx)
+		// the fetched page waiting to be sent is one of the pages ahead
+		pages := make(chan page, prefetch-1)
+		complete := false
+		go func() {
+			defer close(pages)
+			for pd.HasNext() {
+				rows, err := pd.GetNextWithContext(fetchCtx)
+				select {
+				case pages <- page{rows, err}:
+				case <-fetchCtx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+			complete = true
+		}()
+		defer func() {
+			// stop the goroutine and wait for it to finish
+			cancel()
+			for range pages {
+			}
+		}()
+
+		for p := range pages {
+			if p.err != nil {
+				yield(nil, p.err)
+				return
+			}
+			if !yield(p.rows, nil) {
+				return
+			}
+		}
+		if !complete {
+			err := ctx.Err()
+			if err == nil {
+				err = context.Canceled
+			}
+			yield(nil, err)
+		}
+	}
+}
+
 // rowsWithContext returns an iterator for all elements from the pager queried with user provided context.
 func rowsWithContext[T PaginatedRow](ctx context.Context, pd Pager[T]) iter.Seq2[T, error] {
+	return rowsFromPages(pagesWithContext(ctx, pd))
+}
+
+// rowsFromPages returns an iterator for all elements of the pages.
+func rowsFromPages[T PaginatedRow](pages iter.Seq2[[]T, error]) iter.Seq2[T, error] {
 	return func(yield func(T, error) bool) {
-		for rows, err := range pagesWithContext(ctx, pd) {
+		for rows, err := range pages {
 			if err != nil {
 				yield(*new(T), err)
 				return
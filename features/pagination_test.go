@@ -19,6 +19,7 @@ package features
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -67,7 +68,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return newBasePager(newTestPager(opts)), nil
 			},
 		}
@@ -93,7 +94,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return newBasePager(newTestPager(opts)), nil
 			},
 		}
@@ -124,7 +125,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return pager, nil
 			},
 		}
@@ -155,7 +156,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return pager, nil
 			},
 		}
@@ -188,7 +189,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return newBasePager(newTestPager(opts)), nil
 			},
 		}
@@ -210,7 +211,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return pager, nil
 			},
 		}
@@ -237,7 +238,7 @@ var _ = Describe(`Iterators tests`, func() {
 		pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
 			service: service,
 			options: opts,
-			newPager: func(c *cloudantv1.CloudantV1, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+			newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
 				return pager, nil
 			},
 		}
@@ -261,4 +262,121 @@ var _ = Describe(`Iterators tests`, func() {
 		Expect(i).To(Equal(23))
 		Expect(*pd.options.Bookmark).To(Equal("23"))
 	})
+
+	Context(`with prefetch`, func() {
+		var (
+			pager    *countingPager
+			requests func() int64
+		)
+
+		newPrefetchPagination := func(pages int) Pagination[cloudantv1.Document] {
+			opts.SetLimit(23)
+			pager = &countingPager{Pager: newBasePager(newTestPager(opts))}
+			requests = pager.requests.Load
+			pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{
+				service: service,
+				options: opts,
+				newPager: func(c cloudantv1.CloudantV1API, opts *cloudantv1.PostFindOptions) (Pager[cloudantv1.Document], error) {
+					return pager, nil
+				},
+			}
+			return pagination.WithPrefetch(pages)
+		}
+
+		It(`Confirms pagination Pages() returns all pages in order`, func() {
+			pagination := newPrefetchPagination(2)
+			ms.makeItems(5*23 - 1)
+			docs := ms.documents()
+			pageNum := 0
+			for page, err := range pagination.PagesWithContext(ctx) {
+				Expect(err).ShouldNot(HaveOccurred())
+				start := pageNum * 23
+				Expect(page).To(Equal(docs[start:min(start+23, len(docs))]))
+				pageNum += 1
+			}
+			Expect(pageNum).To(Equal(5))
+		})
+
+		It(`Confirms pagination Rows() returns all rows in order`, func() {
+			pagination := newPrefetchPagination(3)
+			ms.makeItems(5*23 - 1)
+			docs := ms.documents()
+			i := 0
+			for item, err := range pagination.RowsWithContext(ctx) {
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(item).To(Equal(docs[i]))
+				i += 1
+			}
+			Expect(i).To(Equal(len(docs)))
+		})
+
+		It(`Confirms prefetching is bounded and stops on break`, func() {
+			pagination := newPrefetchPagination(2)
+			ms.makeItems(10 * 23)
+			for _, err := range pagination.PagesWithContext(ctx) {
+				Expect(err).ShouldNot(HaveOccurred())
+				// the consumed page and 2 pages ahead
+				Eventually(requests).Should(Equal(int64(3)))
+				Consistently(requests, "50ms").Should(Equal(int64(3)))
+				break
+			}
+			Consistently(requests, "50ms").Should(Equal(int64(3)))
+		})
+
+		It(`Confirms pagination Pages() returns errors in order`, func() {
+			pagination := newPrefetchPagination(3)
+			ms.makeItems(5*23 - 1)
+			ms.setError(http.ErrServerClosed, 47)
+			pageNum := 0
+			for _, err := range pagination.PagesWithContext(ctx) {
+				if pageNum == 2 {
+					Expect(err).Should(MatchError(http.ErrServerClosed))
+					continue
+				}
+				Expect(err).ShouldNot(HaveOccurred())
+				pageNum += 1
+			}
+			Expect(pageNum).To(Equal(2))
+			Expect(requests()).To(Equal(int64(3)))
+		})
+
+		It(`Confirms pagination Rows() stops on context cancellation`, func() {
+			pagination := newPrefetchPagination(2)
+			ms.makeItems(10 * 23)
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			i := 0
+			var lastErr error
+			for _, err := range pagination.RowsWithContext(cancelCtx) {
+				if err != nil {
+					lastErr = err
+					continue
+				}
+				i += 1
+				if i == 23 {
+					cancel()
+				}
+			}
+			Expect(lastErr).To(MatchError(context.Canceled))
+			Expect(i).To(BeNumerically("<", 10*23))
+		})
+
+		It(`Confirms prefetch is disabled for less than one page`, func() {
+			pagination := &paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]{}
+			Expect(pagination.WithPrefetch(-1).(*paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]).prefetch).To(Equal(0))
+			Expect(pagination.prefetch).To(Equal(0))
+			Expect(pagination.WithPrefetch(4).(*paginationImplementor[*cloudantv1.PostFindOptions, cloudantv1.Document]).prefetch).To(Equal(4))
+		})
+	})
 })
+
+// countingPager counts requests for pages
+type countingPager struct {
+	Pager[cloudantv1.Document]
+	requests atomic.Int64
+}
+
+func (p *countingPager) GetNextWithContext(ctx context.Context) ([]cloudantv1.Document, error) {
+	p.requests.Add(1)
+	return p.Pager.GetNextWithContext(ctx)
+}
@@ -149,6 +149,20 @@ func (s *mockService) getItems(start, limit, skip int) ([]mockDoc, error) {
 	return acc, nil
 }
 
+// getItemsDescending mocks a descending service call starting at start
+func (s *mockService) getItemsDescending(start, limit, skip int) []mockDoc {
+	acc := make([]mockDoc, 0)
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if i+1 <= start-skip {
+			acc = append(acc, s.items[i])
+		}
+		if len(acc) == limit {
+			break
+		}
+	}
+	return acc
+}
+
 // getDocuments is a converter from slice of mock documents to a slice of cloudantv1.Document
 func (s *mockService) getDocuments(items []mockDoc) []cloudantv1.Document {
 	docs := make([]cloudantv1.Document, len(items))
@@ -216,10 +230,11 @@ func mockServerCallback(w http.ResponseWriter, r *http.Request, ms *mockService)
 	Expect(err).ShouldNot(HaveOccurred())
 
 	q := struct {
-		Limit    int    `json:"limit"`
-		Skip     int    `json:"skip"`
-		StartKey string `json:"start_key"`
-		Bookmark string `json:"bookmark"`
+		Limit      int    `json:"limit"`
+		Skip       int    `json:"skip"`
+		StartKey   string `json:"start_key"`
+		Bookmark   string `json:"bookmark"`
+		Descending bool   `json:"descending"`
 	}{}
 	err = json.Unmarshal(body, &q)
 	Expect(err).ShouldNot(HaveOccurred())
@@ -237,6 +252,9 @@ func mockServerCallback(w http.ResponseWriter, r *http.Request, ms *mockService)
 	var data []byte
 
 	items, err := ms.getItems(startKey, q.Limit, q.Skip)
+	if q.Descending {
+		items = ms.getItemsDescending(startKey, q.Limit, q.Skip)
+	}
 	if err != nil {
 		statusCode = ms.statusCode
 		data = []byte(err.Error())
@@ -0,0 +1,592 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultScanWorkers is the default number of ranges scanned concurrently.
+const DefaultScanWorkers int = 4
+
+// maxBoundaryProbes is the maximum number of probes bisecting the keys for a boundary.
+const maxBoundaryProbes = 16
+
+// ScanOptions configures a parallel Scanner.
+type ScanOptions struct {
+	// The number of ranges scanned concurrently, defaults to DefaultScanWorkers.
+	Workers int
+
+	// The number of key ranges to split the scan into.
+	// Defaults to the number of shards of the database from GetShardsInformation,
+	// or to the number of workers if the shards information is not available.
+	Ranges int
+
+	// When true rows are returned in key order,
+	// otherwise in the order the pages of the ranges are received.
+	Ordered bool
+}
+
+// scanBoundary is a key, and for views a document ID, splitting two ranges.
+type scanBoundary struct {
+	key   any
+	docID *string
+}
+
+// scanProbe is the position of the first row from a probe of the keys.
+type scanProbe struct {
+	// the total number of rows of the index
+	total int64
+	// the number of rows of the index before the probed key in scan order,
+	// nil if the server did not return the offset
+	offset *int64
+	// the first row from the probed key, nil if there are no more rows
+	row *scanBoundary
+}
+
+// probeResult is the part of _all_docs and view responses read by probes.
+type probeResult struct {
+	TotalRows *int64 `json:"total_rows"`
+	Offset    *int64 `json:"offset"`
+	Rows      []struct {
+		ID  *string         `json:"id"`
+		Key json.RawMessage `json:"key"`
+	} `json:"rows"`
+}
+
+// Scanner reads all the rows of _all_docs or a view
+// with a pager per key range running concurrently.
+type Scanner[T PaginatedRow] struct {
+	service cloudantv1.CloudantV1API
+	db      string
+	options ScanOptions
+	// probe returns the first row from a key, or from the start of the scan for a nil key.
+	// A reverse probe returns the last row of the scan instead.
+	probe func(ctx context.Context, key any, reverse bool) (*scanProbe, error)
+	// endKey is the end key of the options, nil for the end of the index
+	endKey any
+	// newRange returns the pagination for the range between two boundaries,
+	// nil boundaries are the start and end of the scan.
+	newRange func(start, end *scanBoundary) Pagination[T]
+}
+
+// NewAllDocsScanner creates a new parallel scanner for all documents operations.
+// The limit of the options is the page size of each range.
+func NewAllDocsScanner(c cloudantv1.CloudantV1API, o *cloudantv1.PostAllDocsOptions, so ScanOptions) (*Scanner[cloudantv1.DocsResultRow], error) {
+	if err := validateScanOptions(so, keyPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+	opts := *o
+	return &Scanner[cloudantv1.DocsResultRow]{
+		service: c,
+		db:      *opts.Db,
+		options: so,
+		endKey:  stringOrNil(opts.EndKey),
+		probe: func(ctx context.Context, key any, reverse bool) (*scanProbe, error) {
+			probeOpts := opts
+			probeOpts.Limit, probeOpts.Skip = core.Int64Ptr(1), nil
+			probeOpts.IncludeDocs = nil
+			if key != nil {
+				probeOpts.StartKey = core.StringPtr(key.(string))
+			}
+			if reverse {
+				probeOpts.Descending = core.BoolPtr(opts.Descending == nil || !*opts.Descending)
+				probeOpts.StartKey, probeOpts.EndKey = opts.EndKey, opts.StartKey
+				probeOpts.InclusiveEnd = nil
+			}
+			result, _, err := c.PostAllDocsAsStreamWithContext(ctx, &probeOpts)
+			if err != nil {
+				return nil, err
+			}
+			return readScanProbe(result)
+		},
+		newRange: func(start, end *scanBoundary) Pagination[cloudantv1.DocsResultRow] {
+			rangeOpts := opts
+			if start != nil {
+				rangeOpts.StartKey = core.StringPtr(start.key.(string))
+				rangeOpts.Skip = nil
+			}
+			if end != nil {
+				rangeOpts.EndKey = core.StringPtr(end.key.(string))
+				rangeOpts.InclusiveEnd = core.BoolPtr(false)
+			}
+			return NewAllDocsPagination(c, &rangeOpts)
+		},
+	}, nil
+}
+
+// NewViewScanner creates a new parallel scanner for views operations.
+// The limit of the options is the page size of each range.
+func NewViewScanner(c cloudantv1.CloudantV1API, o *cloudantv1.PostViewOptions, so ScanOptions) (*Scanner[cloudantv1.ViewResultRow], error) {
+	if err := validateScanOptions(so, keyPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+	opts := *o
+	return &Scanner[cloudantv1.ViewResultRow]{
+		service: c,
+		db:      *opts.Db,
+		options: so,
+		endKey:  opts.EndKey,
+		probe: func(ctx context.Context, key any, reverse bool) (*scanProbe, error) {
+			probeOpts := opts
+			probeOpts.Limit, probeOpts.Skip = core.Int64Ptr(1), nil
+			probeOpts.IncludeDocs, probeOpts.Reduce = nil, core.BoolPtr(false)
+			if key != nil {
+				probeOpts.StartKey, probeOpts.StartKeyDocID = key, nil
+			}
+			if reverse {
+				probeOpts.Descending = core.BoolPtr(opts.Descending == nil || !*opts.Descending)
+				probeOpts.StartKey, probeOpts.EndKey = opts.EndKey, opts.StartKey
+				probeOpts.StartKeyDocID, probeOpts.EndKeyDocID = opts.EndKeyDocID, opts.StartKeyDocID
+				probeOpts.InclusiveEnd = nil
+			}
+			result, _, err := c.PostViewAsStreamWithContext(ctx, &probeOpts)
+			if err != nil {
+				return nil, err
+			}
+			return readScanProbe(result)
+		},
+		newRange: func(start, end *scanBoundary) Pagination[cloudantv1.ViewResultRow] {
+			rangeOpts := opts
+			if start != nil {
+				rangeOpts.StartKey, rangeOpts.StartKeyDocID = start.key, start.docID
+				rangeOpts.Skip = nil
+			}
+			if end != nil {
+				rangeOpts.EndKey, rangeOpts.EndKeyDocID = end.key, end.docID
+				rangeOpts.InclusiveEnd = core.BoolPtr(false)
+			}
+			return NewViewPagination(c, &rangeOpts)
+		},
+	}, nil
+}
+
+// validateScanOptions validates the scan options and the pager options.
+func validateScanOptions[O pagerOptions](so ScanOptions, rules map[string]string, o O) error {
+	if so.Workers < 0 {
+		return fmt.Errorf("the number of workers %d must not be negative", so.Workers)
+	}
+	if so.Ranges < 0 {
+		return fmt.Errorf("the number of ranges %d must not be negative", so.Ranges)
+	}
+	return validatePagerOptions(rules, o)
+}
+
+// Rows returns an iterator for all rows from all ranges.
+func (s *Scanner[T]) Rows() iter.Seq2[T, error] {
+	return s.RowsWithContext(context.Background())
+}
+
+// RowsWithContext returns an iterator for all rows from all ranges queried with user provided context.
+// Breaking the loop or an error stops the scan of all the ranges.
+func (s *Scanner[T]) RowsWithContext(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ranges, err := s.RangesWithContext(ctx)
+		if err != nil {
+			yield(*new(T), err)
+			return
+		}
+		for rows, err := range s.scan(ctx, ranges) {
+			if err != nil {
+				yield(*new(T), err)
+				return
+			}
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RangesWithContext splits the scan into key ranges of about the same number of rows,
+// returning a pagination for each range in key order.
+// The boundaries are found by bisecting the keys between the first and the last row
+// of the scan with probes of a single row from a start key, using the offset of the rows
+// to measure the ranges, so the ranges are balanced without skipping rows.
+// A scan uses a single range when the server does not return the offset of the rows
+// or the keys cannot be bisected, for example object keys.
+func (s *Scanner[T]) RangesWithContext(ctx context.Context) ([]Pagination[T], error) {
+	workers := s.options.Workers
+	if workers == 0 {
+		workers = DefaultScanWorkers
+	}
+	n := s.options.Ranges
+	if n == 0 {
+		n = workers
+		shards, _, err := s.service.GetShardsInformationWithContext(ctx, &cloudantv1.GetShardsInformationOptions{Db: core.StringPtr(s.db)})
+		if err == nil && len(shards.Shards) > 0 {
+			n = len(shards.Shards)
+		}
+	}
+
+	boundaries, err := s.boundaries(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	boundaries = append(append([]*scanBoundary{nil}, boundaries...), nil)
+	ranges := make([]Pagination[T], len(boundaries)-1)
+	for i := range ranges {
+		ranges[i] = s.newRange(boundaries[i], boundaries[i+1])
+	}
+	return ranges, nil
+}
+
+// rankedKey is a key and the offset of the rows from the key.
+type rankedKey struct {
+	key  any
+	rank int64
+}
+
+// boundaries returns up to n-1 boundaries splitting the scan into ranges
+// of about the same number of rows.
+func (s *Scanner[T]) boundaries(ctx context.Context, n int) ([]*scanBoundary, error) {
+	first, err := s.probe(ctx, nil, false)
+	if err != nil || n < 2 || first.offset == nil || first.row == nil {
+		return nil, err
+	}
+	low := rankedKey{first.row.key, *first.offset}
+
+	// the end of the scan is the end key or the last row
+	var high rankedKey
+	if s.endKey != nil {
+		end, err := s.probe(ctx, s.endKey, false)
+		if err != nil || end.offset == nil {
+			return nil, err
+		}
+		high = rankedKey{s.endKey, *end.offset}
+	} else {
+		last, err := s.probe(ctx, nil, true)
+		if err != nil || last.row == nil {
+			return nil, err
+		}
+		high = rankedKey{last.row.key, first.total - 1}
+	}
+	rows := high.rank - low.rank
+	if rows < int64(n) {
+		return nil, nil
+	}
+	tolerance := max(1, rows/int64(n*maxBoundaryProbes))
+
+	start := low.rank
+	boundaries := make([]*scanBoundary, 0, n-1)
+	for i := 1; i < n; i++ {
+		target := start + int64(i)*rows/int64(n)
+		boundary, rank, err := s.bisect(ctx, low, high, target, tolerance)
+		if err != nil {
+			return nil, err
+		}
+		if boundary == nil || rank <= low.rank {
+			continue
+		}
+		boundaries = append(boundaries, boundary)
+		low = rankedKey{boundary.key, rank}
+	}
+	return boundaries, nil
+}
+
+// bisect probes keys between low and high for the first row closest to the target offset.
+// Probes are rejected when the offset of their row is outside the bisected range,
+// as the server collation of the keys may differ from the bisection of the keys.
+func (s *Scanner[T]) bisect(ctx context.Context, low, high rankedKey, target, tolerance int64) (*scanBoundary, int64, error) {
+	var best *scanBoundary
+	var bestRank int64
+	for range maxBoundaryProbes {
+		key, ok := midKey(low.key, high.key)
+		if !ok {
+			break
+		}
+		p, err := s.probe(ctx, key, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		if p.offset == nil || *p.offset < low.rank || *p.offset > high.rank {
+			break
+		}
+		rank := *p.offset
+		if p.row != nil && (best == nil || abs(rank-target) < abs(bestRank-target)) {
+			best, bestRank = p.row, rank
+		}
+		if abs(rank-target) <= tolerance {
+			break
+		}
+		if rank < target {
+			// the first row from the key is the closest known key
+			low = rankedKey{key, rank}
+			if p.row != nil {
+				low.key = p.row.key
+			}
+		} else {
+			high = rankedKey{key, rank}
+		}
+	}
+	return best, bestRank, nil
+}
+
+// readScanProbe reads the first row and the offset from a probe response.
+func readScanProbe(body io.ReadCloser) (*scanProbe, error) {
+	defer body.Close()
+	var result probeResult
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	if err := dec.Decode(&result); err != nil {
+		return nil, err
+	}
+	p := &scanProbe{offset: result.Offset}
+	if result.TotalRows != nil {
+		p.total = *result.TotalRows
+	}
+	if len(result.Rows) > 0 {
+		var key any
+		dec := json.NewDecoder(bytes.NewReader(result.Rows[0].Key))
+		dec.UseNumber()
+		if err := dec.Decode(&key); err != nil {
+			return nil, err
+		}
+		p.row = &scanBoundary{key: key, docID: result.Rows[0].ID}
+	}
+	return p, nil
+}
+
+// midKey returns a key between two keys of the same type,
+// or false if there is none.
+// Strings are bisected by code points, numbers by value and
+// arrays by their first different element.
+func midKey(a, b any) (any, bool) {
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return midString(x, y)
+		}
+	case json.Number:
+		if y, ok := b.(json.Number); ok {
+			return midNumber(x, y)
+		}
+	case []any:
+		y, ok := b.([]any)
+		if !ok {
+			return nil, false
+		}
+		for i := 0; i < len(x) && i < len(y); i++ {
+			if reflect.DeepEqual(x[i], y[i]) {
+				continue
+			}
+			mid, ok := midKey(x[i], y[i])
+			if !ok {
+				return nil, false
+			}
+			return append(append([]any{}, x[:i]...), mid), true
+		}
+	}
+	return nil, false
+}
+
+// maxRune is the number of valid code points, excluding surrogates.
+const maxRune = 0x110000 - 0x800
+
+// midString returns the string halfway between two strings in code point order.
+// The characters after the common prefix are read as the digits of a number,
+// eight ASCII characters or three code points when any of them is not ASCII.
+func midString(a, b string) (string, bool) {
+	if a > b {
+		a, b = b, a
+	}
+	ra, rb := []rune(a), []rune(b)
+	prefix := 0
+	for prefix < len(ra) && prefix < len(rb) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	base, digits := int64(0x80), 8
+	for _, r := range append(ra[prefix:min(prefix+digits, len(ra))], rb[prefix:min(prefix+digits, len(rb))]...) {
+		if r >= 0x80 {
+			base, digits = maxRune, 3
+			break
+		}
+	}
+	value := func(r []rune) *big.Int {
+		v := new(big.Int)
+		for i := prefix; i < prefix+digits; i++ {
+			digit := int64(0)
+			if i < len(r) {
+				digit = int64(r[i])
+				if digit >= 0xE000 {
+					digit -= 0x800
+				}
+			}
+			v.Mul(v, big.NewInt(base)).Add(v, big.NewInt(digit))
+		}
+		return v
+	}
+	mid := new(big.Int).Add(value(ra), value(rb))
+	mid.Rsh(mid, 1)
+	tail := make([]rune, digits)
+	for i := digits - 1; i >= 0; i-- {
+		var digit big.Int
+		mid.DivMod(mid, big.NewInt(base), &digit)
+		tail[i] = rune(digit.Int64())
+		if tail[i] >= 0xD800 {
+			tail[i] += 0x800
+		}
+	}
+	for len(tail) > 0 && tail[len(tail)-1] == 0 {
+		tail = tail[:len(tail)-1]
+	}
+	m := string(ra[:prefix]) + string(tail)
+	return m, a < m && m < b
+}
+
+// midNumber returns the number halfway between two numbers.
+func midNumber(a, b json.Number) (json.Number, bool) {
+	x, okX := new(big.Float).SetString(a.String())
+	y, okY := new(big.Float).SetString(b.String())
+	if !okX || !okY {
+		return "", false
+	}
+	mid := new(big.Float).Add(x, y)
+	mid.Quo(mid, big.NewFloat(2))
+	if mid.Cmp(x) == 0 || mid.Cmp(y) == 0 {
+		return "", false
+	}
+	return json.Number(mid.Text('g', -1)), true
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func stringOrNil(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+// scan returns an iterator for the pages of the ranges read by a bounded number of workers.
+func (s *Scanner[T]) scan(ctx context.Context, ranges []Pagination[T]) iter.Seq2[[]T, error] {
+	type page struct {
+		rows []T
+		err  error
+	}
+	workers := s.options.Workers
+	if workers == 0 {
+		workers = DefaultScanWorkers
+	}
+	return func(yield func([]T, error) bool) {
+		scanCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		// ordered scans read a channel per range in order, unordered scans a shared channel
+		outputs := make([]chan page, len(ranges))
+		shared := make(chan page, workers)
+		for i := range outputs {
+			outputs[i] = shared
+			if s.options.Ordered {
+				outputs[i] = make(chan page, 1)
+			}
+		}
+
+		// ranges are started in order so the first unfinished range always has a worker
+		tokens := make(chan struct{}, workers)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var rangesWg sync.WaitGroup
+			defer func() {
+				rangesWg.Wait()
+				if !s.options.Ordered {
+					close(shared)
+				}
+			}()
+			for i, r := range ranges {
+				select {
+				case tokens <- struct{}{}:
+				case <-scanCtx.Done():
+					if s.options.Ordered {
+						for _, output := range outputs[i:] {
+							close(output)
+						}
+					}
+					return
+				}
+				rangesWg.Add(1)
+				go func(r Pagination[T], output chan page) {
+					defer rangesWg.Done()
+					defer func() { <-tokens }()
+					if s.options.Ordered {
+						defer close(output)
+					}
+					for rows, err := range r.PagesWithContext(scanCtx) {
+						select {
+						case output <- page{rows, err}:
+						case <-scanCtx.Done():
+							return
+						}
+					}
+				}(r, outputs[i])
+			}
+		}()
+
+		read := func(output chan page) bool {
+			for p := range output {
+				if p.err != nil {
+					yield(nil, p.err)
+					return false
+				}
+				if !yield(p.rows, nil) {
+					return false
+				}
+			}
+			return true
+		}
+		if !s.options.Ordered {
+			if read(shared) {
+				checkScanComplete(ctx, yield)
+			}
+			return
+		}
+		for _, output := range outputs {
+			if !read(output) {
+				return
+			}
+		}
+		checkScanComplete(ctx, yield)
+	}
+}
+
+// checkScanComplete returns the context error of a scan stopped by the context.
+func checkScanComplete[T PaginatedRow](ctx context.Context, yield func([]T, error) bool) {
+	if err := ctx.Err(); err != nil {
+		yield(nil, err)
+	}
+}
@@ -0,0 +1,329 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newRangeServer returns a test server for key range queries
+// of _all_docs and views of documents with sorted IDs
+func newRangeServer(ids []string, shards int, inFlight *atomic.Int64, maxInFlight *atomic.Int64, failOn string) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		w.Header().Set("content-type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/_shards") {
+			if shards == 0 {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error":"forbidden","reason":"no access"}`)
+				return
+			}
+			result := cloudantv1.ShardsInformation{Shards: map[string][]string{}}
+			for i := range shards {
+				result.Shards[fmt.Sprintf("%08x-%08x", i, i)] = []string{"node1"}
+			}
+			Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+			return
+		}
+
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		mu.Lock()
+		if current > maxInFlight.Load() {
+			maxInFlight.Store(current)
+		}
+		mu.Unlock()
+
+		body, err := readRequestBody(r)
+		if err != nil {
+			// the request was cancelled by a stopped scan
+			return
+		}
+		q := struct {
+			Limit        *int    `json:"limit"`
+			Skip         int     `json:"skip"`
+			StartKey     *string `json:"start_key"`
+			EndKey       *string `json:"end_key"`
+			InclusiveEnd *bool   `json:"inclusive_end"`
+			Descending   bool    `json:"descending"`
+		}{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+		if failOn != "" && q.StartKey != nil && *q.StartKey == failOn {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":"internal_server_error","reason":"failed"}`)
+			return
+		}
+
+		// before reports whether a key is before another in the order of the request
+		before := func(a, b string) bool {
+			if q.Descending {
+				return a > b
+			}
+			return a < b
+		}
+		ordered := slices.Clone(ids)
+		if q.Descending {
+			slices.Reverse(ordered)
+		}
+		offset := 0
+		rows := make([]map[string]any, 0)
+		for _, id := range ordered {
+			if q.StartKey != nil && before(id, *q.StartKey) {
+				offset++
+				continue
+			}
+			if q.EndKey != nil && (before(*q.EndKey, id) || (id == *q.EndKey && q.InclusiveEnd != nil && !*q.InclusiveEnd)) {
+				continue
+			}
+			rows = append(rows, map[string]any{"id": id, "key": id, "value": map[string]any{"rev": "1-a"}})
+		}
+		rows = rows[min(q.Skip, len(rows)):]
+		if q.Limit != nil {
+			rows = rows[:min(*q.Limit, len(rows))]
+		}
+		Expect(json.NewEncoder(w).Encode(map[string]any{"total_rows": len(ids), "offset": offset, "rows": rows})).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Parallel scanner tests`, func() {
+	var (
+		server      *httptest.Server
+		service     *cloudantv1.CloudantV1
+		ids         []string
+		inFlight    atomic.Int64
+		maxInFlight atomic.Int64
+	)
+
+	start := func(shards int, failOn string) {
+		inFlight.Store(0)
+		maxInFlight.Store(0)
+		server = newRangeServer(ids, shards, &inFlight, &maxInFlight, failOn)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ids = make([]string, 0)
+		for i := range 1000 {
+			ids = append(ids, fmt.Sprintf("%04d", i))
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Scans all docs in key order`, func() {
+		start(8, "")
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(50)
+		scanner, err := NewAllDocsScanner(service, opts, ScanOptions{Workers: 3, Ordered: true})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ranges, err := scanner.RangesWithContext(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ranges).To(HaveLen(8))
+
+		scanned := make([]string, 0)
+		for row, err := range scanner.Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			scanned = append(scanned, *row.ID)
+		}
+		Expect(scanned).To(Equal(ids))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 3))
+	})
+
+	It(`Scans a view unordered with ranges from the workers`, func() {
+		start(0, "")
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetLimit(40)
+		scanner, err := NewViewScanner(service, opts, ScanOptions{Workers: 5})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ranges, err := scanner.RangesWithContext(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ranges).To(HaveLen(5))
+
+		scanned := make([]string, 0)
+		for row, err := range scanner.Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			scanned = append(scanned, *row.ID)
+		}
+		slices.Sort(scanned)
+		Expect(scanned).To(Equal(ids))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 5))
+	})
+
+	It(`Scans within the start and end keys`, func() {
+		start(4, "")
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetStartKey("0100")
+		opts.SetEndKey("0199")
+		opts.SetLimit(10)
+		scanner, err := NewAllDocsScanner(service, opts, ScanOptions{Ranges: 6, Ordered: true})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// the ranges split the rows between the start and end keys evenly
+		ranges, err := scanner.RangesWithContext(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ranges).To(HaveLen(6))
+		for _, r := range ranges {
+			pager, err := r.Pager()
+			Expect(err).ShouldNot(HaveOccurred())
+			rows, err := pager.GetAll()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(rows)).To(BeNumerically("~", 100/6, 3))
+		}
+
+		scanned := make([]string, 0)
+		for row, err := range scanner.Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			scanned = append(scanned, *row.ID)
+		}
+		Expect(scanned).To(Equal(ids[100:200]))
+	})
+
+	It(`Scans uneven keys in descending order`, func() {
+		ids = make([]string, 0)
+		for i := range 300 {
+			ids = append(ids, fmt.Sprintf("a%05d", i*i))
+		}
+		for i := range 300 {
+			ids = append(ids, fmt.Sprintf("zz%03d", i))
+		}
+		start(4, "")
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetDescending(true)
+		opts.SetLimit(50)
+		scanner, err := NewViewScanner(service, opts, ScanOptions{Ordered: true})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ranges, err := scanner.RangesWithContext(context.Background())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ranges).To(HaveLen(4))
+
+		scanned := make([]string, 0)
+		for row, err := range scanner.Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			scanned = append(scanned, *row.ID)
+		}
+		expected := slices.Clone(ids)
+		slices.Reverse(expected)
+		Expect(scanned).To(Equal(expected))
+	})
+
+	It(`Bisects keys`, func() {
+		for _, c := range []struct {
+			a, b any
+			ok   bool
+		}{
+			{"a", "c", true},
+			{"ab", "ac", true},
+			{"abc", "abc", false},
+			{"0999", "0000", true},
+			{json.Number("1"), json.Number("2"), true},
+			{[]any{"x", json.Number("1")}, []any{"x", json.Number("9")}, true},
+			{[]any{"x"}, []any{"x", json.Number("9")}, false},
+			{map[string]any{}, map[string]any{"a": true}, false},
+			{"a", json.Number("1"), false},
+		} {
+			mid, ok := midKey(c.a, c.b)
+			Expect(ok).To(Equal(c.ok), fmt.Sprintf("%v %v", c.a, c.b))
+			if a, isString := c.a.(string); isString && ok {
+				b := c.b.(string)
+				m := mid.(string)
+				Expect(min(a, b) < m && m < max(a, b)).To(BeTrue())
+			}
+		}
+		mid, _ := midKey([]any{"x", json.Number("1")}, []any{"x", json.Number("9")})
+		Expect(mid).To(Equal([]any{"x", json.Number("5")}))
+	})
+
+	It(`Scans an empty database`, func() {
+		ids = nil
+		start(4, "")
+		scanner, err := NewAllDocsScanner(service, service.NewPostAllDocsOptions("db"), ScanOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for range scanner.Rows() {
+			Fail("unexpected row")
+		}
+	})
+
+	It(`Stops the scan on errors`, func() {
+		start(4, "0500")
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(10)
+		scanner, err := NewAllDocsScanner(service, opts, ScanOptions{Ordered: true})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		count := 0
+		var scanErr error
+		for _, err := range scanner.Rows() {
+			if err != nil {
+				scanErr = err
+				continue
+			}
+			count++
+		}
+		Expect(scanErr).To(HaveOccurred())
+		Expect(count).To(Equal(500))
+		Eventually(inFlight.Load).Should(BeZero())
+	})
+
+	It(`Stops the scan on break`, func() {
+		start(4, "")
+		opts := service.NewPostAllDocsOptions("db")
+		opts.SetLimit(10)
+		scanner, err := NewAllDocsScanner(service, opts, ScanOptions{})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, err := range scanner.Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			break
+		}
+		Eventually(inFlight.Load).Should(BeZero())
+	})
+
+	It(`Validates options`, func() {
+		start(4, "")
+		_, err := NewAllDocsScanner(service, service.NewPostAllDocsOptions("db"), ScanOptions{Workers: -1})
+		Expect(err).Should(HaveOccurred())
+		_, err = NewViewScanner(service, service.NewPostViewOptions("db", "ddoc", "view"), ScanOptions{Ranges: -1})
+		Expect(err).Should(HaveOccurred())
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetKey("a")
+		_, err = NewViewScanner(service, opts, ScanOptions{})
+		Expect(err).To(MatchError(ErrKeySet))
+	})
+})
@@ -0,0 +1,427 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type queriesPagerQuery interface {
+	*cloudantv1.AllDocsQuery | *cloudantv1.ViewQuery
+}
+
+type queriesPagerQueryValue interface {
+	cloudantv1.AllDocsQuery | cloudantv1.ViewQuery
+}
+
+// QueriesPagination is a pagination of a batch of queries run together
+// with a single request for the next pages of all the unfinished queries.
+type QueriesPagination[T PaginatedRow] interface {
+	// Pager returns new QueriesPager.
+	Pager() (QueriesPager[T], error)
+
+	// Pages returns an iterator for the next pages of all the queries.
+	Pages() iter.Seq2[[][]T, error]
+
+	// PagesWithContext returns an iterator for the next pages of all the queries
+	// queried with user provided context.
+	PagesWithContext(context.Context) iter.Seq2[[][]T, error]
+}
+
+// QueriesPager is an interface for pagination of a batch of queries.
+// Each query is paginated independently by key, the pages of the queries
+// are indexed in the order of the queries of the options.
+type QueriesPager[T PaginatedRow] interface {
+	// HasNext returns false if there are no more pages for any query.
+	HasNext() bool
+
+	// GetNext retrieves the next page of each query,
+	// a query without more pages has a nil page.
+	GetNext() ([][]T, error)
+
+	// GetNextWithContext retrieves the next page of each query with user provided context.
+	GetNextWithContext(context.Context) ([][]T, error)
+
+	// GetAll retrieves all elements of each query.
+	GetAll() ([][]T, error)
+
+	// GetAllWithContext retrieves all elements of each query with user provided context.
+	GetAllWithContext(context.Context) ([][]T, error)
+
+	// Rows returns an iterator for all elements of the query at the index.
+	// The next pages of the other queries are fetched in the same request
+	// and kept until read.
+	Rows(query int) iter.Seq2[T, error]
+
+	// RowsWithContext returns an iterator for all elements of the query at the index
+	// queried with user provided context.
+	RowsWithContext(ctx context.Context, query int) iter.Seq2[T, error]
+}
+
+// NewAllDocsQueriesPagination creates a new pagination for all documents queries operations.
+func NewAllDocsQueriesPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostAllDocsQueriesOptions) QueriesPagination[cloudantv1.DocsResultRow] {
+	opts := *o
+	return &queriesPagination[cloudantv1.AllDocsQuery, cloudantv1.DocsResultRow]{
+		queries: opts.Queries,
+		requestFunction: func(ctx context.Context, queries []cloudantv1.AllDocsQuery) ([][]cloudantv1.DocsResultRow, error) {
+			requestOpts := opts
+			requestOpts.Queries = queries
+			result, _, err := c.PostAllDocsQueriesWithContext(ctx, &requestOpts)
+			if err != nil {
+				return nil, err
+			}
+			pages := make([][]cloudantv1.DocsResultRow, len(result.Results))
+			for i, r := range result.Results {
+				pages[i] = r.Rows
+			}
+			return pages, nil
+		},
+	}
+}
+
+// NewDesignDocsQueriesPagination creates a new pagination for design documents queries operations.
+func NewDesignDocsQueriesPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostDesignDocsQueriesOptions) QueriesPagination[cloudantv1.DocsResultRow] {
+	opts := *o
+	return &queriesPagination[cloudantv1.AllDocsQuery, cloudantv1.DocsResultRow]{
+		queries: opts.Queries,
+		requestFunction: func(ctx context.Context, queries []cloudantv1.AllDocsQuery) ([][]cloudantv1.DocsResultRow, error) {
+			requestOpts := opts
+			requestOpts.Queries = queries
+			result, _, err := c.PostDesignDocsQueriesWithContext(ctx, &requestOpts)
+			if err != nil {
+				return nil, err
+			}
+			pages := make([][]cloudantv1.DocsResultRow, len(result.Results))
+			for i, r := range result.Results {
+				pages[i] = r.Rows
+			}
+			return pages, nil
+		},
+	}
+}
+
+// NewViewQueriesPagination creates a new pagination for views queries operations.
+func NewViewQueriesPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostViewQueriesOptions) QueriesPagination[cloudantv1.ViewResultRow] {
+	opts := *o
+	return &queriesPagination[cloudantv1.ViewQuery, cloudantv1.ViewResultRow]{
+		queries: opts.Queries,
+		requestFunction: func(ctx context.Context, queries []cloudantv1.ViewQuery) ([][]cloudantv1.ViewResultRow, error) {
+			requestOpts := opts
+			requestOpts.Queries = queries
+			result, _, err := c.PostViewQueriesWithContext(ctx, &requestOpts)
+			if err != nil {
+				return nil, err
+			}
+			pages := make([][]cloudantv1.ViewResultRow, len(result.Results))
+			for i, r := range result.Results {
+				pages[i] = r.Rows
+			}
+			return pages, nil
+		},
+	}
+}
+
+type queriesPagination[Q queriesPagerQueryValue, T keyPaginatedRow] struct {
+	queries         []Q
+	requestFunction func(context.Context, []Q) ([][]T, error)
+}
+
+func (pi *queriesPagination[Q, T]) Pager() (QueriesPager[T], error) {
+	if len(pi.queries) == 0 {
+		return nil, errors.New("there are no queries to paginate")
+	}
+	p := &queriesPager[Q, T]{
+		requestFunction: pi.requestFunction,
+		states:          make([]*queryState[Q, T], len(pi.queries)),
+	}
+	for i, q := range pi.queries {
+		if err := validateQueriesPagerQuery(q); err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		pageSize := int64(maxLimit)
+		if limit := queryLimit(q); limit != nil {
+			pageSize = *limit
+		}
+		p.states[i] = &queryState[Q, T]{query: q, pageSize: pageSize, hasNextPage: true}
+	}
+	return p, nil
+}
+
+func (pi *queriesPagination[Q, T]) Pages() iter.Seq2[[][]T, error] {
+	return pi.PagesWithContext(context.Background())
+}
+
+func (pi *queriesPagination[Q, T]) PagesWithContext(ctx context.Context) iter.Seq2[[][]T, error] {
+	return func(yield func([][]T, error) bool) {
+		pager, err := pi.Pager()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for pager.HasNext() {
+			pages, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(pages, nil) {
+				return
+			}
+		}
+	}
+}
+
+// queryState is the paging state of one query of the batch.
+// Fields:
+//   - query: The query options for the next page.
+//   - pageSize: The number of items per page of the query.
+//   - hasNextPage: False when the last page of the query was received.
+//   - pages: The received pages not yet read.
+//   - err: Holds any errors encountered while paging the query.
+type queryState[Q queriesPagerQueryValue, T keyPaginatedRow] struct {
+	query       Q
+	pageSize    int64
+	hasNextPage bool
+	pages       [][]T
+	err         error
+}
+
+// addPage keeps the page of the result rows and sets the start of the next page.
+func (s *queryState[Q, T]) addPage(rows []T) {
+	if int64(len(rows)) <= s.pageSize {
+		s.hasNextPage = false
+		s.pages = append(s.pages, rows)
+		return
+	}
+	s.pages = append(s.pages, rows[:s.pageSize])
+	key, docID := queryRowKey(rows[s.pageSize])
+	if lastKey, lastDocID := queryRowKey(rows[s.pageSize-1]); docID != nil && lastDocID != nil &&
+		*docID == *lastDocID && reflect.DeepEqual(key, lastKey) {
+		s.err = fmt.Errorf("cannot paginate on a boundary containing identical keys %q and document IDs %q", key, *docID)
+		return
+	}
+	s.query = nextQueryPageOptions(s.query, key, docID)
+}
+
+type queriesPager[Q queriesPagerQueryValue, T keyPaginatedRow] struct {
+	requestFunction func(context.Context, []Q) ([][]T, error)
+	states          []*queryState[Q, T]
+}
+
+// HasNext returns false if there are no more pages for any query.
+func (p *queriesPager[Q, T]) HasNext() bool {
+	for _, s := range p.states {
+		if s.hasNextPage || len(s.pages) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNext retrieves the next page of each query,
+// a query without more pages has a nil page.
+func (p *queriesPager[Q, T]) GetNext() ([][]T, error) {
+	return p.GetNextWithContext(context.Background())
+}
+
+// GetNextWithContext retrieves the next page of each query with user provided context.
+func (p *queriesPager[Q, T]) GetNextWithContext(ctx context.Context) ([][]T, error) {
+	if !p.HasNext() {
+		return nil, ErrNoMoreResults
+	}
+	for _, s := range p.states {
+		if len(s.pages) == 0 && s.err != nil {
+			return nil, s.err
+		}
+	}
+	if err := p.fetch(ctx); err != nil {
+		return nil, err
+	}
+	pages := make([][]T, len(p.states))
+	for i, s := range p.states {
+		if len(s.pages) > 0 {
+			pages[i], s.pages = s.pages[0], s.pages[1:]
+		}
+	}
+	return pages, nil
+}
+
+// GetAll retrieves all elements of each query.
+func (p *queriesPager[Q, T]) GetAll() ([][]T, error) {
+	return p.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext retrieves all elements of each query with user provided context.
+func (p *queriesPager[Q, T]) GetAllWithContext(ctx context.Context) ([][]T, error) {
+	acc := make([][]T, len(p.states))
+	for i := range acc {
+		acc[i] = make([]T, 0)
+	}
+	for p.HasNext() {
+		pages, err := p.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i, page := range pages {
+			acc[i] = append(acc[i], page...)
+		}
+	}
+	return acc, nil
+}
+
+// Rows returns an iterator for all elements of the query at the index.
+func (p *queriesPager[Q, T]) Rows(query int) iter.Seq2[T, error] {
+	return p.RowsWithContext(context.Background(), query)
+}
+
+// RowsWithContext returns an iterator for all elements of the query at the index
+// queried with user provided context.
+func (p *queriesPager[Q, T]) RowsWithContext(ctx context.Context, query int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if query < 0 || query >= len(p.states) {
+			yield(*new(T), fmt.Errorf("the query index %d is out of range of %d queries", query, len(p.states)))
+			return
+		}
+		s := p.states[query]
+		for {
+			if len(s.pages) == 0 {
+				if s.err != nil {
+					yield(*new(T), s.err)
+					return
+				}
+				if !s.hasNextPage {
+					return
+				}
+				if err := p.fetch(ctx); err != nil {
+					yield(*new(T), err)
+					return
+				}
+				continue
+			}
+			var page []T
+			page, s.pages = s.pages[0], s.pages[1:]
+			for _, row := range page {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// fetch requests the next page of every query without unread pages.
+func (p *queriesPager[Q, T]) fetch(ctx context.Context) error {
+	indexes := make([]int, 0, len(p.states))
+	queries := make([]Q, 0, len(p.states))
+	for i, s := range p.states {
+		if s.hasNextPage && s.err == nil && len(s.pages) == 0 {
+			indexes = append(indexes, i)
+			// one more row than the page size gives the start of the next page
+			queries = append(queries, withQueryLimit(s.query, s.pageSize+1))
+		}
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	results, err := p.requestFunction(ctx, queries)
+	if err != nil {
+		return err
+	}
+	if len(results) != len(queries) {
+		return fmt.Errorf("received %d results for %d queries", len(results), len(queries))
+	}
+	for j, i := range indexes {
+		p.states[i].addPage(results[j])
+	}
+	return nil
+}
+
+// validateQueriesPagerQuery validates a query of the batch.
+func validateQueriesPagerQuery[Q queriesPagerQueryValue](q Q) error {
+	switch query := any(&q).(type) {
+	case *cloudantv1.AllDocsQuery:
+		err := validatePagerOptions(keyPagerValidationRules, query)
+		if errors.Is(err, ErrKeySet) {
+			err = fmt.Errorf(`%w. No need to paginate as "Key" returns a single result for an ID`, err)
+		}
+		return err
+	case *cloudantv1.ViewQuery:
+		err := validatePagerOptions(keyPagerValidationRules, query)
+		if errors.Is(err, ErrKeySet) {
+			err = fmt.Errorf(`%w. Use StartKey and EndKey instead`, err)
+		}
+		return err
+	}
+	return ErrNotImplemented
+}
+
+// queryLimit returns the limit of the query.
+func queryLimit[Q queriesPagerQueryValue](q Q) *int64 {
+	switch query := any(q).(type) {
+	case cloudantv1.AllDocsQuery:
+		return query.Limit
+	case cloudantv1.ViewQuery:
+		return query.Limit
+	}
+	return nil
+}
+
+// withQueryLimit returns a copy of the query with the limit.
+func withQueryLimit[Q queriesPagerQueryValue](q Q, limit int64) Q {
+	switch query := any(&q).(type) {
+	case *cloudantv1.AllDocsQuery:
+		query.Limit = core.Int64Ptr(limit)
+	case *cloudantv1.ViewQuery:
+		query.Limit = core.Int64Ptr(limit)
+	}
+	return q
+}
+
+// nextQueryPageOptions returns a copy of the query starting at the key and document ID.
+func nextQueryPageOptions[Q queriesPagerQueryValue](q Q, key any, docID *string) Q {
+	switch query := any(&q).(type) {
+	case *cloudantv1.AllDocsQuery:
+		if k, ok := key.(string); ok {
+			query.StartKey = &k
+		}
+		query.Skip = nil
+	case *cloudantv1.ViewQuery:
+		query.StartKey, query.StartKeyDocID = key, docID
+		query.Skip = nil
+	}
+	return q
+}
+
+// queryRowKey returns the key and, for views, the document ID of a row.
+func queryRowKey[T keyPaginatedRow](row T) (any, *string) {
+	switch r := any(row).(type) {
+	case cloudantv1.DocsResultRow:
+		if r.Key != nil {
+			return *r.Key, nil
+		}
+	case cloudantv1.ViewResultRow:
+		return r.Key, r.ID
+	}
+	return nil, nil
+}
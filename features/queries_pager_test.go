@@ -0,0 +1,203 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newQueriesServer returns a test server for multi-query requests
+// of _all_docs, _design_docs and views of documents with sorted IDs
+func newQueriesServer(ids []string, requests *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		Expect(r.URL.Path).To(HaveSuffix("/queries"))
+		w.Header().Set("content-type", "application/json")
+		body, err := readRequestBody(r)
+		Expect(err).ShouldNot(HaveOccurred())
+		q := struct {
+			Queries []struct {
+				Limit    *int    `json:"limit"`
+				Skip     int     `json:"skip"`
+				StartKey *string `json:"start_key"`
+				EndKey   *string `json:"end_key"`
+			} `json:"queries"`
+		}{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+		*requests = append(*requests, len(q.Queries))
+
+		results := make([]map[string]any, 0, len(q.Queries))
+		for _, query := range q.Queries {
+			rows := make([]map[string]any, 0)
+			for _, id := range ids {
+				if strings.HasPrefix(r.URL.Path, "/db/_design_docs") != strings.HasPrefix(id, "_design/") {
+					continue
+				}
+				if query.StartKey != nil && id < *query.StartKey {
+					continue
+				}
+				if query.EndKey != nil && id > *query.EndKey {
+					continue
+				}
+				rows = append(rows, map[string]any{"id": id, "key": id, "value": map[string]any{"rev": "1-a"}})
+			}
+			rows = rows[min(query.Skip, len(rows)):]
+			if query.Limit != nil {
+				rows = rows[:min(*query.Limit, len(rows))]
+			}
+			results = append(results, map[string]any{"total_rows": len(ids), "rows": rows})
+		}
+		Expect(json.NewEncoder(w).Encode(map[string]any{"results": results})).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Queries pager tests`, func() {
+	var (
+		server   *httptest.Server
+		service  *cloudantv1.CloudantV1
+		ids      []string
+		requests []int
+	)
+
+	BeforeEach(func() {
+		ids = make([]string, 0)
+		for i := range 100 {
+			ids = append(ids, fmt.Sprintf("%03d", i))
+		}
+		for i := range 5 {
+			ids = append(ids, fmt.Sprintf("_design/%d", i))
+		}
+		requests = nil
+		server = newQueriesServer(ids, &requests)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Pages all docs queries together`, func() {
+		opts := service.NewPostAllDocsQueriesOptions("db", []cloudantv1.AllDocsQuery{
+			{Limit: core.Int64Ptr(10), EndKey: core.StringPtr("024")},
+			{Limit: core.Int64Ptr(20), StartKey: core.StringPtr("050"), Skip: core.Int64Ptr(5)},
+		})
+		pager, err := NewAllDocsQueriesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		pageSizes := make([][]int, 0)
+		for pager.HasNext() {
+			pages, err := pager.GetNext()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pages).To(HaveLen(2))
+			pageSizes = append(pageSizes, []int{len(pages[0]), len(pages[1])})
+		}
+		Expect(pageSizes).To(Equal([][]int{{10, 20}, {10, 20}, {5, 5}}))
+		Expect(requests).To(Equal([]int{2, 2, 2}))
+		_, err = pager.GetNext()
+		Expect(err).To(MatchError(ErrNoMoreResults))
+	})
+
+	It(`Gets all rows of each query`, func() {
+		opts := service.NewPostViewQueriesOptions("db", "ddoc", "view", []cloudantv1.ViewQuery{
+			{Limit: core.Int64Ptr(7), StartKey: "010", EndKey: "029"},
+			{Limit: core.Int64Ptr(50)},
+		})
+		all, err := NewViewQueriesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		rows, err := all.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rows[0]).To(HaveLen(20))
+		Expect(*rows[0][0].ID).To(Equal("010"))
+		Expect(*rows[0][19].ID).To(Equal("029"))
+		Expect(rows[1]).To(HaveLen(len(ids) - 5))
+		// finished queries are not requested again
+		Expect(requests).To(Equal([]int{2, 2, 1}))
+	})
+
+	It(`Iterates the rows of each query`, func() {
+		opts := service.NewPostAllDocsQueriesOptions("db", []cloudantv1.AllDocsQuery{
+			{Limit: core.Int64Ptr(10), EndKey: core.StringPtr("019")},
+			{Limit: core.Int64Ptr(10), StartKey: core.StringPtr("090")},
+		})
+		pager, err := NewAllDocsQueriesPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		first := make([]string, 0)
+		for row, err := range pager.Rows(0) {
+			Expect(err).ShouldNot(HaveOccurred())
+			first = append(first, *row.ID)
+		}
+		Expect(first).To(Equal(ids[:20]))
+		second := make([]string, 0)
+		for row, err := range pager.Rows(1) {
+			Expect(err).ShouldNot(HaveOccurred())
+			second = append(second, *row.ID)
+		}
+		Expect(second).To(Equal(ids[90:100]))
+		// the pages of the second query were fetched with the first query
+		Expect(requests).To(Equal([]int{2, 1}))
+		Expect(pager.HasNext()).To(BeFalse())
+
+		for _, err := range pager.Rows(2) {
+			Expect(err).Should(HaveOccurred())
+		}
+	})
+
+	It(`Iterates the pages of design docs queries`, func() {
+		opts := service.NewPostDesignDocsQueriesOptions("db", []cloudantv1.AllDocsQuery{
+			{Limit: core.Int64Ptr(2)},
+		})
+		count := 0
+		for pages, err := range NewDesignDocsQueriesPagination(service, opts).Pages() {
+			Expect(err).ShouldNot(HaveOccurred())
+			count += len(pages[0])
+		}
+		Expect(count).To(Equal(5))
+	})
+
+	It(`Validates the queries`, func() {
+		_, err := NewAllDocsQueriesPagination(service, service.NewPostAllDocsQueriesOptions("db", []cloudantv1.AllDocsQuery{
+			{},
+			{Key: core.StringPtr("a")},
+		})).Pager()
+		Expect(err).To(MatchError(ErrKeySet))
+		Expect(err.Error()).To(HavePrefix("query 1:"))
+
+		_, err = NewViewQueriesPagination(service, service.NewPostViewQueriesOptions("db", "ddoc", "view", []cloudantv1.ViewQuery{
+			{Limit: core.Int64Ptr(1000)},
+		})).Pager()
+		Expect(err).Should(HaveOccurred())
+
+		for _, err := range NewViewQueriesPagination(service, service.NewPostViewQueriesOptions("db", "ddoc", "view", nil)).Pages() {
+			Expect(err).Should(HaveOccurred())
+		}
+	})
+})
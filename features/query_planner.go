@@ -0,0 +1,404 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ErrUnindexedQuery is returned by a strict QueryPlanner
+// for queries that would not be served by an index.
+var ErrUnindexedQuery = errors.New("query is not served by an index")
+
+// allDocsIndexName is the name of the special index used for full scans.
+const allDocsIndexName = "_all_docs"
+
+// IndexCandidateReport describes an index that was not chosen for a query.
+type IndexCandidateReport struct {
+	// The design document ID of the index, empty for special indexes.
+	Ddoc string
+
+	// The name of the index.
+	Name string
+
+	// The type of the index, json, text or special.
+	Type string
+
+	// Whether the index could serve the query.
+	Usable bool
+
+	// The ranking of the index, lower is better.
+	Ranking int64
+
+	// The exclusion reasons, for example field_mismatch.
+	Reasons []string
+}
+
+// QueryPlan is a report on how the server would execute a query.
+type QueryPlan struct {
+	// The explain result the report is based on.
+	Explain *cloudantv1.ExplainResult
+
+	// The design document ID of the chosen index, empty for special indexes.
+	Ddoc string
+
+	// The name of the chosen index.
+	Index string
+
+	// Whether the query falls back to scanning _all_docs.
+	FullScan bool
+
+	// Whether the query is answered from the index without fetching documents.
+	Covering bool
+
+	// Sort fields not provided by the chosen index.
+	UnusedSortFields []string
+
+	// Selector fields not provided by the chosen index.
+	UnindexedFields []string
+
+	// The other indexes considered for the query.
+	Candidates []IndexCandidateReport
+
+	// A JSON index definition that would serve the query,
+	// nil when the chosen index already provides all the query fields.
+	SuggestedIndex *cloudantv1.IndexDefinition
+}
+
+// Indexed returns true when the query is served by an index other than _all_docs.
+func (p *QueryPlan) Indexed() bool {
+	return !p.FullScan
+}
+
+// String returns a human readable summary of the plan.
+func (p *QueryPlan) String() string {
+	var b strings.Builder
+	if p.FullScan {
+		b.WriteString("full scan of _all_docs")
+	} else {
+		fmt.Fprintf(&b, "index %s/%s", p.Ddoc, p.Index)
+	}
+	if len(p.UnindexedFields) > 0 {
+		fmt.Fprintf(&b, "; unindexed fields %v", p.UnindexedFields)
+	}
+	if len(p.UnusedSortFields) > 0 {
+		fmt.Fprintf(&b, "; unused sort fields %v", p.UnusedSortFields)
+	}
+	if p.SuggestedIndex != nil {
+		fields := make([]string, len(p.SuggestedIndex.Fields))
+		for i, f := range p.SuggestedIndex.Fields {
+			for name := range f.GetProperties() {
+				fields[i] = name
+			}
+		}
+		fmt.Fprintf(&b, "; suggested index on %v", fields)
+	}
+	return b.String()
+}
+
+// QueryPlanner explains queries before they run.
+type QueryPlanner struct {
+	service cloudantv1.CloudantV1API
+
+	// When true PostFind refuses to run queries falling back to _all_docs.
+	Strict bool
+}
+
+// NewQueryPlanner creates a new query planner for the service.
+func NewQueryPlanner(c cloudantv1.CloudantV1API) *QueryPlanner {
+	return &QueryPlanner{service: c}
+}
+
+// Explain returns the plan for the query options.
+func (q *QueryPlanner) Explain(o *cloudantv1.PostFindOptions) (*QueryPlan, error) {
+	return q.ExplainWithContext(context.Background(), o)
+}
+
+// ExplainWithContext returns the plan for the query options.
+func (q *QueryPlanner) ExplainWithContext(ctx context.Context, o *cloudantv1.PostFindOptions) (*QueryPlan, error) {
+	if o == nil {
+		return nil, core.SDKErrorf(nil, "query options cannot be nil", "nil-find-options", common.GetComponentInfo())
+	}
+	explainOptions := cloudantv1.PostExplainOptions(*o)
+	result, _, err := q.service.PostExplainWithContext(ctx, &explainOptions)
+	if err != nil {
+		return nil, err
+	}
+	return newQueryPlan(o, result), nil
+}
+
+// PostFind runs the query, refusing unindexed queries in strict mode.
+func (q *QueryPlanner) PostFind(o *cloudantv1.PostFindOptions) (*cloudantv1.FindResult, *core.DetailedResponse, error) {
+	return q.PostFindWithContext(context.Background(), o)
+}
+
+// PostFindWithContext runs the query, refusing unindexed queries in strict mode.
+// In strict mode the returned error wraps ErrUnindexedQuery and is a *QueryPlanError.
+func (q *QueryPlanner) PostFindWithContext(ctx context.Context, o *cloudantv1.PostFindOptions) (*cloudantv1.FindResult, *core.DetailedResponse, error) {
+	if q.Strict {
+		plan, err := q.ExplainWithContext(ctx, o)
+		if err != nil {
+			return nil, nil, err
+		}
+		if plan.FullScan {
+			return nil, nil, &QueryPlanError{Plan: plan}
+		}
+	}
+	return q.service.PostFindWithContext(ctx, o)
+}
+
+// QueryPlanError is returned by a strict QueryPlanner for unindexed queries.
+type QueryPlanError struct {
+	Plan *QueryPlan
+}
+
+func (e *QueryPlanError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnindexedQuery, e.Plan)
+}
+
+func (e *QueryPlanError) Unwrap() error {
+	return ErrUnindexedQuery
+}
+
+// newQueryPlan interprets the explain result for the query options.
+func newQueryPlan(o *cloudantv1.PostFindOptions, result *cloudantv1.ExplainResult) *QueryPlan {
+	plan := &QueryPlan{Explain: result}
+	if result.Covering != nil {
+		plan.Covering = *result.Covering
+	}
+
+	var indexed map[string]bool
+	if index := result.Index; index != nil {
+		plan.Ddoc = stringValue(index.Ddoc)
+		plan.Index = stringValue(index.Name)
+		plan.FullScan = stringValue(index.Type) == cloudantv1.IndexInformationTypeSpecialConst &&
+			plan.Index == allDocsIndexName
+		if !plan.FullScan {
+			indexed = indexFields(index.Def)
+		}
+	} else {
+		plan.FullScan = true
+	}
+	if indexed == nil {
+		// _all_docs only provides _id
+		indexed = map[string]bool{"_id": true}
+	}
+
+	for _, candidate := range result.IndexCandidates {
+		report := IndexCandidateReport{}
+		if candidate.Index != nil {
+			report.Ddoc = stringValue(candidate.Index.Ddoc)
+			report.Name = stringValue(candidate.Index.Name)
+			report.Type = stringValue(candidate.Index.Type)
+		}
+		if analysis := candidate.Analysis; analysis != nil {
+			if analysis.Usable != nil {
+				report.Usable = *analysis.Usable
+			}
+			if analysis.Ranking != nil {
+				report.Ranking = *analysis.Ranking
+			}
+			for _, reason := range analysis.Reasons {
+				report.Reasons = append(report.Reasons, stringValue(reason.Name))
+			}
+		}
+		plan.Candidates = append(plan.Candidates, report)
+	}
+
+	for _, s := range o.Sort {
+		for field := range s {
+			if !indexed[field] {
+				plan.UnusedSortFields = append(plan.UnusedSortFields, field)
+			}
+		}
+	}
+
+	equality, ranged := selectorIndexFields(o.Selector)
+	for _, field := range append(append([]string{}, equality...), ranged...) {
+		if !indexed[field] {
+			plan.UnindexedFields = append(plan.UnindexedFields, field)
+		}
+	}
+
+	if plan.FullScan || len(plan.UnindexedFields) > 0 || len(plan.UnusedSortFields) > 0 {
+		plan.SuggestedIndex = suggestIndex(equality, ranged, o.Sort)
+	}
+	return plan
+}
+
+// suggestIndex returns a JSON index definition with the equality fields first,
+// then the sort fields in order and then the remaining range fields.
+// Sort fields keep their own direction, the other fields are ascending.
+func suggestIndex(equality, ranged []string, sortOrder []map[string]string) *cloudantv1.IndexDefinition {
+	added := make(map[string]bool)
+	var fields []cloudantv1.IndexField
+	add := func(name, direction string) {
+		if added[name] {
+			return
+		}
+		added[name] = true
+		field := cloudantv1.IndexField{}
+		field.SetProperty(name, core.StringPtr(direction))
+		fields = append(fields, field)
+	}
+
+	sortFields := make(map[string]bool)
+	for _, s := range sortOrder {
+		for name := range s {
+			sortFields[name] = true
+		}
+	}
+	// equality fields before the sort fields keep
+	// the sort order for the matching documents
+	for _, name := range equality {
+		if !sortFields[name] {
+			add(name, "asc")
+		}
+	}
+	for _, s := range sortOrder {
+		for name, direction := range s {
+			add(name, direction)
+		}
+	}
+	for _, name := range ranged {
+		add(name, "asc")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &cloudantv1.IndexDefinition{Fields: fields}
+}
+
+// indexFields returns the field names of a JSON index definition.
+func indexFields(def *cloudantv1.IndexDefinition) map[string]bool {
+	fields := make(map[string]bool)
+	if def == nil {
+		return fields
+	}
+	isDirection := func(s *string) bool {
+		return s != nil && (*s == "asc" || *s == "desc")
+	}
+	for _, f := range def.Fields {
+		// JSON index fields on "name" or "type" decode into the
+		// Name and Type of text index fields, {"name": "asc"}
+		switch {
+		case f.Type == nil && isDirection(f.Name):
+			fields["name"] = true
+		case f.Name == nil && isDirection(f.Type):
+			fields["type"] = true
+		case f.Name != nil:
+			fields[*f.Name] = true
+		}
+		for name := range f.GetProperties() {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+// selectorIndexFields returns the fields of the selector usable as the basis of
+// an index, split into equality and range conditions and sorted by name.
+// Only fields that must match for every document are considered,
+// that is fields outside of $or, $nor and $not.
+func selectorIndexFields(s map[string]interface{}) (equality []string, ranged []string) {
+	// normalize through JSON to walk hand-built selectors
+	// such as $and with a []map[string]interface{} value
+	if b, err := json.Marshal(s); err == nil {
+		var normalized map[string]interface{}
+		if err := json.Unmarshal(b, &normalized); err == nil {
+			s = normalized
+		}
+	}
+	eq := make(map[string]bool)
+	rng := make(map[string]bool)
+	collectSelectorFields("", s, eq, rng)
+	for field := range eq {
+		equality = append(equality, field)
+	}
+	for field := range rng {
+		if !eq[field] {
+			ranged = append(ranged, field)
+		}
+	}
+	sort.Strings(equality)
+	sort.Strings(ranged)
+	return equality, ranged
+}
+
+func collectSelectorFields(prefix string, s map[string]interface{}, eq, rng map[string]bool) {
+	for key, value := range s {
+		if key == "$and" {
+			if list, ok := value.([]interface{}); ok {
+				for _, item := range list {
+					if m, ok := item.(map[string]interface{}); ok {
+						collectSelectorFields(prefix, m, eq, rng)
+					}
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(key, "$") {
+			// $or, $nor, $not and condition operators
+			// cannot be used as the basis of an index
+			continue
+		}
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+		conditions, ok := value.(map[string]interface{})
+		if !ok {
+			eq[field] = true
+			continue
+		}
+		hasOperator := false
+		for op := range conditions {
+			if strings.HasPrefix(op, "$") {
+				hasOperator = true
+				break
+			}
+		}
+		if !hasOperator {
+			collectSelectorFields(field, conditions, eq, rng)
+			continue
+		}
+		for op := range conditions {
+			switch op {
+			case "$eq":
+				eq[field] = true
+			case "$gt", "$gte", "$lt", "$lte", "$beginsWith":
+				rng[field] = true
+			}
+		}
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
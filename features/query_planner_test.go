@@ -0,0 +1,199 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const allDocsExplain = `{
+	"dbname": "db",
+	"index": {"ddoc": null, "name": "_all_docs", "type": "special", "def": {"fields": [{"_id": "asc"}]}},
+	"index_candidates": [
+		{
+			"index": {"ddoc": "_design/by-type", "name": "by-type", "type": "json", "def": {"fields": [{"type": "asc"}]}},
+			"analysis": {"usable": false, "ranking": 1, "covering": false, "reasons": [{"name": "field_mismatch"}]}
+		}
+	],
+	"selector": {"age": {"$gt": 18}},
+	"opts": {}, "limit": 25, "skip": 0, "fields": [], "covering": false
+}`
+
+const jsonIndexExplain = `{
+	"dbname": "db",
+	"index": {"ddoc": "_design/by-type", "name": "by-type", "type": "json", "def": {"fields": [{"type": "asc"}]}},
+	"index_candidates": [],
+	"selector": {"type": {"$eq": "user"}},
+	"opts": {}, "limit": 25, "skip": 0, "fields": [], "covering": false
+}`
+
+var _ = Describe(`QueryPlanner tests`, func() {
+	var (
+		server   *httptest.Server
+		service  *cloudantv1.CloudantV1
+		planner  *QueryPlanner
+		explain  string
+		requests []string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := readRequestBody(r)
+			Expect(err).ShouldNot(HaveOccurred())
+			requests = append(requests, r.URL.Path)
+			w.Header().Set("content-type", "application/json")
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/_explain"):
+				Expect(json.Valid([]byte(body))).To(BeTrue())
+				fmt.Fprint(w, explain)
+			case strings.HasSuffix(r.URL.Path, "/_find"):
+				fmt.Fprint(w, `{"docs": [], "bookmark": "nil"}`)
+			}
+		}))
+
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		planner = NewQueryPlanner(service)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Reports _all_docs fallback with a suggested index`, func() {
+		explain = allDocsExplain
+		opts := service.NewPostFindOptions("db", map[string]interface{}{
+			"type": "user",
+			"age":  map[string]interface{}{"$gt": 18},
+			"$or":  []interface{}{map[string]interface{}{"a": 1}},
+		})
+		opts.SetSort([]map[string]string{{"name": "desc"}})
+
+		plan, err := planner.Explain(opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(requests).To(Equal([]string{"/db/_explain"}))
+		Expect(plan.FullScan).To(BeTrue())
+		Expect(plan.Indexed()).To(BeFalse())
+		Expect(plan.Index).To(Equal("_all_docs"))
+		Expect(plan.UnusedSortFields).To(Equal([]string{"name"}))
+		Expect(plan.UnindexedFields).To(Equal([]string{"type", "age"}))
+		Expect(plan.Candidates).To(Equal([]IndexCandidateReport{{
+			Ddoc:    "_design/by-type",
+			Name:    "by-type",
+			Type:    "json",
+			Ranking: 1,
+			Reasons: []string{"field_mismatch"},
+		}}))
+
+		Expect(plan.SuggestedIndex).ToNot(BeNil())
+		suggested, err := json.Marshal(plan.SuggestedIndex)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(suggested).To(MatchJSON(`{"fields": [{"type": "asc"}, {"name": "desc"}, {"age": "asc"}]}`))
+		Expect(plan.String()).To(Equal("full scan of _all_docs; unindexed fields [type age]; unused sort fields [name]; suggested index on [type name age]"))
+	})
+
+	It(`Reports a query served by an index`, func() {
+		explain = jsonIndexExplain
+		opts := service.NewPostFindOptions("db", map[string]interface{}{
+			"$and": []interface{}{
+				map[string]interface{}{"type": map[string]interface{}{"$eq": "user"}},
+			},
+		})
+
+		plan, err := planner.Explain(opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plan.FullScan).To(BeFalse())
+		Expect(plan.Ddoc).To(Equal("_design/by-type"))
+		Expect(plan.UnindexedFields).To(BeEmpty())
+		Expect(plan.UnusedSortFields).To(BeEmpty())
+		Expect(plan.SuggestedIndex).To(BeNil())
+	})
+
+	It(`Collects nested selector fields`, func() {
+		equality, ranged := selectorIndexFields(map[string]interface{}{
+			"address": map[string]interface{}{"city": "York", "number": map[string]interface{}{"$gte": 1}},
+			"name":    map[string]interface{}{"$beginsWith": "A", "$eq": "Al"},
+			"$not":    map[string]interface{}{"b": 1},
+		})
+		Expect(equality).To(Equal([]string{"address.city", "name"}))
+		Expect(ranged).To(Equal([]string{"address.number"}))
+	})
+
+	It(`Collects fields of hand-built $and selectors`, func() {
+		equality, ranged := selectorIndexFields(map[string]interface{}{
+			"$and": []map[string]interface{}{
+				{"type": "user"},
+				{"age": map[string]interface{}{"$gt": 18}},
+			},
+		})
+		Expect(equality).To(Equal([]string{"type"}))
+		Expect(ranged).To(Equal([]string{"age"}))
+	})
+
+	It(`Refuses unindexed queries in strict mode`, func() {
+		explain = allDocsExplain
+		planner.Strict = true
+		opts := service.NewPostFindOptions("db", map[string]interface{}{"age": map[string]interface{}{"$gt": 18}})
+
+		_, _, err := planner.PostFind(opts)
+		Expect(err).To(MatchError(ErrUnindexedQuery))
+		var planErr *QueryPlanError
+		Expect(errors.As(err, &planErr)).To(BeTrue())
+		Expect(planErr.Plan.FullScan).To(BeTrue())
+		Expect(requests).To(Equal([]string{"/db/_explain"}))
+	})
+
+	It(`Runs indexed queries in strict mode`, func() {
+		explain = jsonIndexExplain
+		planner.Strict = true
+		opts := service.NewPostFindOptions("db", map[string]interface{}{"type": "user"})
+
+		result, _, err := planner.PostFind(opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result.Docs).To(BeEmpty())
+		Expect(requests).To(Equal([]string{"/db/_explain", "/db/_find"}))
+	})
+
+	It(`Runs queries without explaining them when not strict`, func() {
+		opts := service.NewPostFindOptions("db", map[string]interface{}{"age": 1})
+
+		_, _, err := planner.PostFind(opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(requests).To(Equal([]string{"/db/_find"}))
+	})
+
+	It(`Validates options`, func() {
+		_, err := planner.Explain(nil)
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,95 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// NewSchedulerDocsPagination creates a new pagination for replication scheduler documents operations.
+// Documents added or removed while paginating may move between pages.
+func NewSchedulerDocsPagination(c cloudantv1.CloudantV1API, o *cloudantv1.GetSchedulerDocsOptions) Pagination[cloudantv1.SchedulerDocument] {
+	return &paginationImplementor[*cloudantv1.GetSchedulerDocsOptions, cloudantv1.SchedulerDocument]{
+		service:  c,
+		options:  o,
+		newPager: newSchedulerDocsPager,
+	}
+}
+
+// newSchedulerDocsPager creates a new pager for replication scheduler documents operations.
+func newSchedulerDocsPager(c cloudantv1.CloudantV1API, o *cloudantv1.GetSchedulerDocsOptions) (Pager[cloudantv1.SchedulerDocument], error) {
+	if err := validatePagerOptions(skipPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	opts := *o
+	pd := &skipPager[*cloudantv1.GetSchedulerDocsOptions, *cloudantv1.SchedulerDocsResult, cloudantv1.SchedulerDocument]{
+		service:           c,
+		options:           &opts,
+		hasNextPage:       true,
+		requestFunction:   c.GetSchedulerDocsWithContext,
+		resultItemsGetter: func(result *cloudantv1.SchedulerDocsResult) []cloudantv1.SchedulerDocument { return result.Docs },
+		optionsCloner: func(o *cloudantv1.GetSchedulerDocsOptions) *cloudantv1.GetSchedulerDocsOptions {
+			opts := *o
+			return &opts
+		},
+		limitGetter: func() *int64 { return opts.Limit },
+		limitSetter: opts.SetLimit,
+		skipGetter:  func() *int64 { return opts.Skip },
+		skipSetter:  opts.SetSkip,
+	}
+	p := newBasePager(pd)
+
+	return p, nil
+}
+
+// NewSchedulerJobsPagination creates a new pagination for replication scheduler jobs operations.
+// Jobs started or stopped while paginating may move between pages.
+func NewSchedulerJobsPagination(c cloudantv1.CloudantV1API, o *cloudantv1.GetSchedulerJobsOptions) Pagination[cloudantv1.SchedulerJob] {
+	return &paginationImplementor[*cloudantv1.GetSchedulerJobsOptions, cloudantv1.SchedulerJob]{
+		service:  c,
+		options:  o,
+		newPager: newSchedulerJobsPager,
+	}
+}
+
+// newSchedulerJobsPager creates a new pager for replication scheduler jobs operations.
+func newSchedulerJobsPager(c cloudantv1.CloudantV1API, o *cloudantv1.GetSchedulerJobsOptions) (Pager[cloudantv1.SchedulerJob], error) {
+	if err := validatePagerOptions(skipPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	opts := *o
+	pd := &skipPager[*cloudantv1.GetSchedulerJobsOptions, *cloudantv1.SchedulerJobsResult, cloudantv1.SchedulerJob]{
+		service:           c,
+		options:           &opts,
+		hasNextPage:       true,
+		requestFunction:   c.GetSchedulerJobsWithContext,
+		resultItemsGetter: func(result *cloudantv1.SchedulerJobsResult) []cloudantv1.SchedulerJob { return result.Jobs },
+		optionsCloner: func(o *cloudantv1.GetSchedulerJobsOptions) *cloudantv1.GetSchedulerJobsOptions {
+			opts := *o
+			return &opts
+		},
+		limitGetter: func() *int64 { return opts.Limit },
+		limitSetter: opts.SetLimit,
+		skipGetter:  func() *int64 { return opts.Skip },
+		skipSetter:  opts.SetSkip,
+	}
+	p := newBasePager(pd)
+
+	return p, nil
+}
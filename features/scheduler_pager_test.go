@@ -0,0 +1,117 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newSchedulerServer returns a test server for scheduler docs and jobs requests
+func newSchedulerServer(total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		w.Header().Set("content-type", "application/json")
+		q := r.URL.Query()
+		skip, _ := strconv.Atoi(q.Get("skip"))
+		limit, err := strconv.Atoi(q.Get("limit"))
+		Expect(err).ShouldNot(HaveOccurred())
+		items := make([]map[string]any, 0)
+		for i := skip; i < min(skip+limit, total); i++ {
+			items = append(items, map[string]any{"doc_id": fmt.Sprintf("rep%02d", i), "database": "_replicator"})
+		}
+		result := map[string]any{"total_rows": total}
+		switch r.URL.Path {
+		case "/_scheduler/docs":
+			result["docs"] = items
+		case "/_scheduler/jobs":
+			result["jobs"] = items
+		default:
+			Fail("unexpected path " + r.URL.Path)
+		}
+		Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Scheduler pager tests`, func() {
+	var (
+		server  *httptest.Server
+		service *cloudantv1.CloudantV1
+	)
+
+	BeforeEach(func() {
+		server = newSchedulerServer(33)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Iterates scheduler docs`, func() {
+		opts := service.NewGetSchedulerDocsOptions()
+		opts.SetLimit(10)
+		ids := make([]string, 0)
+		for doc, err := range NewSchedulerDocsPagination(service, opts).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			ids = append(ids, *doc.DocID)
+		}
+		Expect(ids).To(HaveLen(33))
+		Expect(ids[0]).To(Equal("rep00"))
+		Expect(ids[32]).To(Equal("rep32"))
+	})
+
+	It(`Pages scheduler jobs from a skip`, func() {
+		opts := service.NewGetSchedulerJobsOptions()
+		opts.SetLimit(10)
+		opts.SetSkip(5)
+		pager, err := NewSchedulerJobsPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		pageSizes := make([]int, 0)
+		first := ""
+		for pager.HasNext() {
+			page, err := pager.GetNext()
+			Expect(err).ShouldNot(HaveOccurred())
+			if first == "" {
+				first = *page[0].DocID
+			}
+			pageSizes = append(pageSizes, len(page))
+		}
+		Expect(first).To(Equal("rep05"))
+		Expect(pageSizes).To(Equal([]int{10, 10, 8}))
+	})
+
+	It(`Validates options`, func() {
+		opts := service.NewGetSchedulerJobsOptions()
+		opts.SetLimit(0)
+		_, err := NewSchedulerJobsPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -17,7 +17,12 @@
 package features
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
 )
 
 // SearchPagerOptions defines options for paginating through searches or partitioned searches in a Cloudant database.
@@ -26,7 +31,7 @@ type SearchPagerOptions interface {
 }
 
 // NewSearchPagination creates a new pagination for searches operations.
-func NewSearchPagination[O SearchPagerOptions](c *cloudantv1.CloudantV1, o O) Pagination[cloudantv1.SearchResultRow] {
+func NewSearchPagination[O SearchPagerOptions](c cloudantv1.CloudantV1API, o O) Pagination[cloudantv1.SearchResultRow] {
 	return &paginationImplementor[O, cloudantv1.SearchResultRow]{
 		service:  c,
 		options:  o,
@@ -35,7 +40,7 @@ func NewSearchPagination[O SearchPagerOptions](c *cloudantv1.CloudantV1, o O) Pa
 }
 
 // newSearchPager creates a new pager for searches operations.
-func newSearchPager[O SearchPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[cloudantv1.SearchResultRow], error) {
+func newSearchPager[O SearchPagerOptions](c cloudantv1.CloudantV1API, o O) (Pager[cloudantv1.SearchResultRow], error) {
 	switch opts := any(o).(type) {
 	case *cloudantv1.PostSearchOptions:
 		if err := validatePagerOptions(searchPagerValidationRules, opts); err != nil {
@@ -43,7 +48,7 @@ func newSearchPager[O SearchPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[
 		}
 
 		pd := newSearchBookmarkPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	case *cloudantv1.PostPartitionSearchOptions:
@@ -52,14 +57,14 @@ func newSearchPager[O SearchPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[
 		}
 
 		pd := newSearchPartitionBookmarkPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	}
 	return nil, ErrNotImplemented
 }
 
-func newSearchBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostSearchOptions) *bookmarkPager[*cloudantv1.PostSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow] {
+func newSearchBookmarkPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostSearchOptions) *bookmarkPager[*cloudantv1.PostSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow] {
 	opts := *o
 	return &bookmarkPager[*cloudantv1.PostSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow]{
 		service:           c,
@@ -78,7 +83,7 @@ func newSearchBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostSearchOp
 	}
 }
 
-func newSearchPartitionBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostPartitionSearchOptions) *bookmarkPager[*cloudantv1.PostPartitionSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow] {
+func newSearchPartitionBookmarkPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionSearchOptions) *bookmarkPager[*cloudantv1.PostPartitionSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow] {
 	opts := *o
 	return &bookmarkPager[*cloudantv1.PostPartitionSearchOptions, *cloudantv1.SearchResult, cloudantv1.SearchResultRow]{
 		service:           c,
@@ -96,3 +101,123 @@ func newSearchPartitionBookmarkPager(c *cloudantv1.CloudantV1, o *cloudantv1.Pos
 		limitSetter: opts.SetLimit,
 	}
 }
+
+// SearchFacets are the counts and ranges facets of a search.
+type SearchFacets struct {
+	// Counts are the number of results for each value of the Counts fields.
+	Counts map[string]map[string]int64
+
+	// Ranges are the number of results in each range of the Ranges fields.
+	Ranges map[string]map[string]int64
+}
+
+// FacetedSearchPagination is a pagination for searches with counts or ranges facets.
+type FacetedSearchPagination interface {
+	Pagination[cloudantv1.SearchResultRow]
+
+	// FacetedPager returns new FacetedSearchPager.
+	FacetedPager() (FacetedSearchPager, error)
+}
+
+// FacetedSearchPager is a Pager for searches with counts or ranges facets.
+type FacetedSearchPager interface {
+	Pager[cloudantv1.SearchResultRow]
+
+	// Facets returns the counts and ranges facets from the first page,
+	// or nil before the first page.
+	Facets() *SearchFacets
+}
+
+// NewFacetedSearchPagination creates a new pagination for searches operations with
+// Counts or Ranges options. The facets are requested with the first page only.
+func NewFacetedSearchPagination(c cloudantv1.CloudantV1API, o *cloudantv1.PostSearchOptions) FacetedSearchPagination {
+	return &facetedSearchPagination{
+		paginationImplementor: &paginationImplementor[*cloudantv1.PostSearchOptions, cloudantv1.SearchResultRow]{
+			service:  c,
+			options:  o,
+			newPager: newFacetedSearchPager,
+		},
+	}
+}
+
+type facetedSearchPagination struct {
+	*paginationImplementor[*cloudantv1.PostSearchOptions, cloudantv1.SearchResultRow]
+}
+
+func (pi *facetedSearchPagination) FacetedPager() (FacetedSearchPager, error) {
+	pager, err := pi.Pager()
+	if err != nil {
+		return nil, err
+	}
+	return pager.(FacetedSearchPager), nil
+}
+
+type facetedSearchPager struct {
+	resumablePager[cloudantv1.SearchResultRow]
+	facets *SearchFacets
+}
+
+// Facets returns the counts and ranges facets from the first page,
+// or nil before the first page.
+func (p *facetedSearchPager) Facets() *SearchFacets {
+	return p.facets
+}
+
+// newFacetedSearchPager creates a new pager for searches operations with facets.
+func newFacetedSearchPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostSearchOptions) (Pager[cloudantv1.SearchResultRow], error) {
+	if err := validatePagerOptions(facetedSearchPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	p := &facetedSearchPager{}
+	pd := newSearchBookmarkPager(c, o)
+	pd.requestFunction = func(ctx context.Context, opts *cloudantv1.PostSearchOptions) (*cloudantv1.SearchResult, *core.DetailedResponse, error) {
+		if p.facets != nil {
+			// the facets of the first page are the facets of all the results
+			pageOpts := *opts
+			pageOpts.Counts, pageOpts.Ranges = nil, nil
+			opts = &pageOpts
+		}
+		result, response, err := c.PostSearchWithContext(ctx, opts)
+		if err == nil && p.facets == nil {
+			p.facets = &SearchFacets{Counts: result.Counts, Ranges: result.Ranges}
+		}
+		return result, response, err
+	}
+	p.resumablePager = newResumableBasePager(pd)
+
+	return p, nil
+}
+
+// ErrGroupLimit is wrapped by the error of a grouped search with more groups than its GroupLimit.
+var ErrGroupLimit = errors.New("the grouped search has more groups than the group limit")
+
+// GetSearchGroups gets the groups of a search with a GroupField in a single request.
+// Grouped search results have no bookmark to page over the groups, so all the groups
+// must be within the GroupLimit, 200 by default and at most, and the Limit is
+// the number of rows of each group. It returns an error wrapping ErrGroupLimit
+// instead of a part of the groups when the search has more groups than the GroupLimit.
+func GetSearchGroups(ctx context.Context, c cloudantv1.CloudantV1API, o *cloudantv1.PostSearchOptions) ([]cloudantv1.SearchResultProperties, error) {
+	if o.GroupField == nil || *o.GroupField == "" {
+		return nil, errors.New(`the option "GroupField" is required for grouped searches`)
+	}
+	if err := validatePagerOptions(groupedSearchPagerValidationRules, o); err != nil {
+		return nil, err
+	}
+
+	groupLimit := int64(maxLimit)
+	if o.GroupLimit != nil {
+		groupLimit = *o.GroupLimit
+	}
+	// one more group than the limit tells if there are more groups
+	opts := *o
+	opts.SetGroupLimit(groupLimit + 1)
+	result, _, err := c.PostSearchWithContext(ctx, &opts)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(result.Groups)) > groupLimit {
+		return nil, fmt.Errorf("%w %d, set a larger GroupLimit or narrow the query", ErrGroupLimit, groupLimit)
+	}
+	return result.Groups, nil
+}
@@ -17,9 +17,12 @@
 package features
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 
 	"github.com/IBM/cloudant-go-sdk/cloudantv1"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -177,3 +180,170 @@ var _ = Describe(`Search pager tests`, func() {
 		})
 	})
 })
+
+// newFacetsServer returns a test server for searches with facets paginated
+// with a bookmark of the offset or with groups without a bookmark
+func newFacetsServer(rows int, groups int, requests *[]map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+		w.Header().Set("content-type", "application/json")
+		body, err := readRequestBody(r)
+		Expect(err).ShouldNot(HaveOccurred())
+		q := map[string]any{}
+		Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+		*requests = append(*requests, q)
+
+		offset := 0
+		if bookmark, ok := q["bookmark"].(string); ok {
+			offset, err = strconv.Atoi(bookmark)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		result := map[string]any{"total_rows": rows}
+		if _, ok := q["group_field"]; ok {
+			end := min(int(q["group_limit"].(float64)), groups)
+			page := make([]map[string]any, 0)
+			for i := 0; i < end; i++ {
+				page = append(page, map[string]any{
+					"by":         fmt.Sprintf("g%02d", i),
+					"total_rows": 1,
+					"rows":       []map[string]any{{"id": fmt.Sprintf("doc%02d", i), "fields": map[string]any{}}},
+				})
+			}
+			result["groups"] = page
+		} else {
+			end := min(offset+int(q["limit"].(float64)), rows)
+			page := make([]map[string]any, 0)
+			for i := offset; i < end; i++ {
+				page = append(page, map[string]any{"id": fmt.Sprintf("doc%02d", i), "fields": map[string]any{}})
+			}
+			result["rows"] = page
+			result["bookmark"] = strconv.Itoa(end)
+			if _, ok := q["counts"]; ok {
+				result["counts"] = map[string]any{"type": map[string]any{"a": 10, "b": rows - 10}}
+			}
+		}
+		Expect(json.NewEncoder(w).Encode(result)).To(Succeed())
+	}))
+}
+
+var _ = Describe(`Faceted and grouped search pager tests`, func() {
+	var (
+		server   *httptest.Server
+		service  *cloudantv1.CloudantV1
+		requests []map[string]any
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		server = newFacetsServer(25, 23, &requests)
+		var err error
+		service, err = cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Returns the facets of the first page`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetLimit(10)
+		opts.SetCounts([]string{"type"})
+		pager, err := NewFacetedSearchPagination(service, opts).FacetedPager()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(pager.Facets()).To(BeNil())
+
+		rows, err := pager.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rows).To(HaveLen(25))
+		Expect(pager.Facets().Counts).To(Equal(map[string]map[string]int64{"type": {"a": 10, "b": 15}}))
+		Expect(pager.Facets().Ranges).To(BeNil())
+
+		Expect(requests).To(HaveLen(3))
+		Expect(requests[0]).To(HaveKey("counts"))
+		Expect(requests[1]).ToNot(HaveKey("counts"))
+		Expect(requests[2]).ToNot(HaveKey("counts"))
+	})
+
+	It(`Iterates the rows of a faceted search`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetLimit(20)
+		opts.SetCounts([]string{"type"})
+		count := 0
+		for _, err := range NewFacetedSearchPagination(service, opts).Rows() {
+			Expect(err).ShouldNot(HaveOccurred())
+			count++
+		}
+		Expect(count).To(Equal(25))
+	})
+
+	It(`Gets the groups of a grouped search in a single request`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetGroupField("type")
+		opts.SetGroupLimit(30)
+		opts.SetLimit(1)
+		groups, err := GetSearchGroups(context.Background(), service, opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(groups).To(HaveLen(23))
+		Expect(*groups[22].By).To(Equal("g22"))
+		Expect(groups[0].Rows).To(HaveLen(1))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]["limit"]).To(BeEquivalentTo(1))
+		Expect(requests[0]["group_limit"]).To(BeEquivalentTo(31))
+		Expect(requests[0]).ToNot(HaveKey("bookmark"))
+		Expect(*opts.GroupLimit).To(BeEquivalentTo(30))
+
+		groups, err = GetSearchGroups(context.Background(), service, service.NewPostSearchOptions("db", "ddoc", "index", "*:*").SetGroupField("type"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(groups).To(HaveLen(23))
+		Expect(requests[1]["group_limit"]).To(BeEquivalentTo(201))
+	})
+
+	It(`Rejects a group limit lower than the number of groups`, func() {
+		for _, groupLimit := range []int64{10, 22} {
+			opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+			opts.SetGroupField("type")
+			opts.SetGroupLimit(groupLimit)
+			groups, err := GetSearchGroups(context.Background(), service, opts)
+			Expect(err).Should(MatchError(ErrGroupLimit))
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("group limit %d", groupLimit)))
+			Expect(groups).To(BeNil())
+		}
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetGroupField("type")
+		opts.SetGroupLimit(23)
+		groups, err := GetSearchGroups(context.Background(), service, opts)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(groups).To(HaveLen(23))
+	})
+
+	It(`Validates faceted and grouped search options`, func() {
+		opts := service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetGroupField("type")
+		_, err := NewFacetedSearchPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+
+		_, err = GetSearchGroups(context.Background(), service, service.NewPostSearchOptions("db", "ddoc", "index", "*:*"))
+		Expect(err).Should(HaveOccurred())
+
+		opts = service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetGroupField("type")
+		opts.SetCounts([]string{"type"})
+		_, err = GetSearchGroups(context.Background(), service, opts)
+		Expect(err).Should(HaveOccurred())
+
+		opts = service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetGroupField("type")
+		opts.SetGroupLimit(201)
+		_, err = GetSearchGroups(context.Background(), service, opts)
+		Expect(err).Should(HaveOccurred())
+
+		opts = service.NewPostSearchOptions("db", "ddoc", "index", "*:*")
+		opts.SetCounts([]string{"type"})
+		_, err = NewSearchPagination(service, opts).Pager()
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,95 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package features
+
+import (
+	"context"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var skipPagerValidationRules = map[string]string{
+	"Limit": limitValidationRule,
+}
+
+type skipPagerOptions interface {
+	*cloudantv1.GetSchedulerDocsOptions | *cloudantv1.GetSchedulerJobsOptions
+}
+
+type skipRequestResult interface {
+	*cloudantv1.SchedulerDocsResult | *cloudantv1.SchedulerJobsResult
+}
+
+type skipPaginatedRow interface {
+	cloudantv1.SchedulerDocument | cloudantv1.SchedulerJob
+}
+
+// skipPager pages through listings without keys by skipping the rows of the previous pages.
+type skipPager[O skipPagerOptions, R skipRequestResult, T skipPaginatedRow] struct {
+	service           cloudantv1.CloudantV1API
+	options           O
+	hasNextPage       bool
+	requestFunction   func(context.Context, O) (R, *core.DetailedResponse, error)
+	resultItemsGetter func(R) []T
+	optionsCloner     func(O) O
+	limitGetter       func() *int64
+	limitSetter       func(int64) O
+	skipGetter        func() *int64
+	skipSetter        func(int64) O
+}
+
+func (p *skipPager[O, R, T]) nextRequestFunction(ctx context.Context) (R, error) {
+	result, _, err := p.requestFunction(ctx, p.options)
+	return result, err
+}
+
+func (p *skipPager[O, R, T]) itemsGetter(result R) ([]T, error) {
+	items := p.resultItemsGetter(result)
+	if p.limitGetter() != nil && len(items) < int(*p.limitGetter()) {
+		p.hasNextPage = false
+	}
+	return items, nil
+}
+
+func (p *skipPager[O, R, T]) hasNext() bool {
+	return p.hasNextPage
+}
+
+func (p *skipPager[O, R, T]) setNextPageOptions(result R) {
+	skip := int64(0)
+	if p.skipGetter() != nil {
+		skip = *p.skipGetter()
+	}
+	p.skipSetter(skip + int64(len(p.resultItemsGetter(result))))
+}
+
+func (p *skipPager[O, R, T]) getOptions() O {
+	return p.optionsCloner(p.options)
+}
+
+func (p *skipPager[O, R, T]) setOptions(o O) {
+	p.options = p.optionsCloner(o)
+}
+
+func (p *skipPager[O, R, T]) getLimit() *int64 {
+	return p.limitGetter()
+}
+
+func (p *skipPager[O, R, T]) setLimit(pageSize int64) {
+	p.limitSetter(pageSize)
+}
@@ -29,7 +29,7 @@ type ViewPagerOptions interface {
 }
 
 // NewViewPagination creates a new pagination for views operations.
-func NewViewPagination[O ViewPagerOptions](c *cloudantv1.CloudantV1, o O) Pagination[cloudantv1.ViewResultRow] {
+func NewViewPagination[O ViewPagerOptions](c cloudantv1.CloudantV1API, o O) Pagination[cloudantv1.ViewResultRow] {
 	return &paginationImplementor[O, cloudantv1.ViewResultRow]{
 		service:  c,
 		options:  o,
@@ -38,7 +38,7 @@ func NewViewPagination[O ViewPagerOptions](c *cloudantv1.CloudantV1, o O) Pagina
 }
 
 // NewViewPager creates a new pager for views operations.
-func newViewPager[O ViewPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[cloudantv1.ViewResultRow], error) {
+func newViewPager[O ViewPagerOptions](c cloudantv1.CloudantV1API, o O) (Pager[cloudantv1.ViewResultRow], error) {
 	if err := validatePagerOptions(keyPagerValidationRules, o); err != nil {
 		if errors.Is(err, ErrKeySet) {
 			err = fmt.Errorf(`%w. Use StartKey and EndKey instead`, err)
@@ -49,12 +49,12 @@ func newViewPager[O ViewPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[clou
 	switch opts := any(o).(type) {
 	case *cloudantv1.PostViewOptions:
 		pd := newViewKeyPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	case *cloudantv1.PostPartitionViewOptions:
 		pd := newViewPartitionKeyPager(c, opts)
-		p := newBasePager(pd)
+		p := newResumableBasePager(pd)
 
 		return p, nil
 	}
@@ -62,7 +62,7 @@ func newViewPager[O ViewPagerOptions](c *cloudantv1.CloudantV1, o O) (Pager[clou
 	return nil, ErrNotImplemented
 }
 
-func newViewKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostViewOptions) *keyPager[*cloudantv1.PostViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow] {
+func newViewKeyPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostViewOptions) *keyPager[*cloudantv1.PostViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow] {
 	opts := *o
 	return &keyPager[*cloudantv1.PostViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow]{
 		service:            c,
@@ -72,6 +72,7 @@ func newViewKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostViewOptions) *k
 		resultItemsGetter:  func(result *cloudantv1.ViewResult) []cloudantv1.ViewResultRow { return result.Rows },
 		startViewKeyGetter: func(item cloudantv1.ViewResultRow) any { return item.Key },
 		startViewKeySetter: opts.SetStartKey,
+		orderedKeys:        true,
 		startKeyDocIDGetter: func(item cloudantv1.ViewResultRow) string {
 			if item.ID != nil {
 				return *item.ID
@@ -89,7 +90,7 @@ func newViewKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostViewOptions) *k
 	}
 }
 
-func newViewPartitionKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostPartitionViewOptions) *keyPager[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow] {
+func newViewPartitionKeyPager(c cloudantv1.CloudantV1API, o *cloudantv1.PostPartitionViewOptions) *keyPager[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow] {
 	opts := *o
 	return &keyPager[*cloudantv1.PostPartitionViewOptions, *cloudantv1.ViewResult, cloudantv1.ViewResultRow]{
 		service:            c,
@@ -99,6 +100,7 @@ func newViewPartitionKeyPager(c *cloudantv1.CloudantV1, o *cloudantv1.PostPartit
 		resultItemsGetter:  func(result *cloudantv1.ViewResult) []cloudantv1.ViewResultRow { return result.Rows },
 		startViewKeyGetter: func(item cloudantv1.ViewResultRow) any { return item.Key },
 		startViewKeySetter: opts.SetStartKey,
+		orderedKeys:        true,
 		startKeyDocIDGetter: func(item cloudantv1.ViewResultRow) string {
 			if item.ID != nil {
 				return *item.ID
@@ -17,6 +17,7 @@
 package features
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -170,3 +171,57 @@ var _ = Describe(`View pager tests`, func() {
 		})
 	})
 })
+
+var _ = Describe(`View pager object keys tests`, func() {
+	It(`Pages with object keys in member order`, func() {
+		startKeys := make([]string, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			body, err := readRequestBody(r)
+			Expect(err).ShouldNot(HaveOccurred())
+			q := struct {
+				StartKey json.RawMessage `json:"start_key"`
+			}{}
+			Expect(json.Unmarshal([]byte(body), &q)).To(Succeed())
+			startKeys = append(startKeys, string(q.StartKey))
+			w.Header().Set("content-type", "application/json")
+			rows := `[{"id":"a","key":{"z":1,"a":1},"value":1},{"id":"b","key":{"z":1,"a":2},"value":1}]`
+			if len(startKeys) > 1 {
+				rows = `[{"id":"b","key":{"z":1,"a":2},"value":1}]`
+			}
+			fmt.Fprintf(w, `{"total_rows":2,"rows":%s}`, rows)
+		}))
+		defer server.Close()
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		opts := service.NewPostViewOptions("db", "ddoc", "view")
+		opts.SetLimit(1)
+		rows, err := NewViewPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		all, err := rows.GetAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(all).To(HaveLen(2))
+		Expect(startKeys).To(Equal([]string{"", `{"z":1,"a":2}`}))
+
+		// a resumed pager starts at the key of the token in member order
+		startKeys = startKeys[:0]
+		rows, err = NewViewPagination(service, opts).Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = rows.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		token, err := rows.(TokenPager[cloudantv1.ViewResultRow]).Token(tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		resumed, err := NewViewPaginationFromToken(service, opts, token, tokenKey)
+		Expect(err).ShouldNot(HaveOccurred())
+		rows, err = resumed.Pager()
+		Expect(err).ShouldNot(HaveOccurred())
+		page, err := rows.GetNext()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(page).To(HaveLen(1))
+		Expect(startKeys).To(Equal([]string{"", `{"z":1,"a":2}`}))
+	})
+})
@@ -0,0 +1,145 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+)
+
+// Collate compares two JSON values with the view collation of CouchDB:
+// null, false, true, numbers, strings, arrays and objects, in this order.
+// Numbers are float64 or json.Number values.
+// Strings are compared case-insensitively first and then with lowercase
+// letters before uppercase ones, which approximates the ICU collation.
+// Objects are compared member by member, in the order of a base.OrderedObject
+// or in the order of the names of a map.
+func Collate(a, b interface{}) int {
+	ra, rb := collationRank(a), collationRank(b)
+	if ra != rb {
+		return compareInts(ra, rb)
+	}
+	switch a := a.(type) {
+	case float64, json.Number:
+		na, nb := number(a), number(b)
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+		return 0
+	case string:
+		return collateStrings(a, b.(string))
+	case []interface{}:
+		b := b.([]interface{})
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if c := Collate(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return compareInts(len(a), len(b))
+	case base.OrderedObject, map[string]interface{}:
+		oa, ob := orderedObject(a), orderedObject(b)
+		for i := 0; i < len(oa) && i < len(ob); i++ {
+			if c := collateStrings(oa[i].Name, ob[i].Name); c != 0 {
+				return c
+			}
+			if c := Collate(oa[i].Value, ob[i].Value); c != 0 {
+				return c
+			}
+		}
+		return compareInts(len(oa), len(ob))
+	}
+	return 0
+}
+
+// number returns the value of a float64 or json.Number number.
+func number(v interface{}) float64 {
+	if n, ok := v.(json.Number); ok {
+		f, _ := n.Float64()
+		return f
+	}
+	return v.(float64)
+}
+
+// compareRaw compares two values like Collate, except strings which are
+// compared by their bytes, as CouchDB compares document IDs.
+func compareRaw(a, b interface{}) int {
+	sa, aok := a.(string)
+	sb, bok := b.(string)
+	if aok && bok {
+		return strings.Compare(sa, sb)
+	}
+	return Collate(a, b)
+}
+
+func collationRank(v interface{}) int {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if v {
+			return 2
+		}
+		return 1
+	case float64, json.Number:
+		return 3
+	case string:
+		return 4
+	case []interface{}:
+		return 5
+	}
+	return 6
+}
+
+func collateStrings(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(b, a)
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// orderedObject returns an object as base.OrderedObject,
+// with the members of maps in the order of their names.
+func orderedObject(v interface{}) base.OrderedObject {
+	switch v := v.(type) {
+	case base.OrderedObject:
+		return v
+	case map[string]interface{}:
+		object := make(base.OrderedObject, 0, len(v))
+		for _, name := range slices.Sorted(maps.Keys(v)) {
+			object = append(object, base.ObjectMember{Name: name, Value: v[name]})
+		}
+		return object
+	}
+	return nil
+}
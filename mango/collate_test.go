@@ -0,0 +1,71 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/mango"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Collate tests`, func() {
+	It(`Orders values with the view collation`, func() {
+		ordered := []interface{}{
+			nil,
+			false,
+			true,
+			-1.0,
+			2.5,
+			"a",
+			"A",
+			"aa",
+			"b",
+			[]interface{}{},
+			[]interface{}{1.0},
+			[]interface{}{1.0, "a"},
+			[]interface{}{2.0},
+			map[string]interface{}{},
+			map[string]interface{}{"a": 1.0},
+			map[string]interface{}{"a": 2.0},
+			map[string]interface{}{"b": 1.0},
+		}
+		for i, a := range ordered {
+			for j, b := range ordered {
+				Expect(mango.Collate(a, b)).To(Equal(compare(i, j)), "%v and %v", a, b)
+			}
+		}
+	})
+
+	It(`Orders objects in the order of their members`, func() {
+		a := base.OrderedObject{{Name: "b", Value: 1.0}, {Name: "a", Value: 1.0}}
+		b := map[string]interface{}{"a": 1.0, "b": 1.0}
+		Expect(mango.Collate(a, b)).To(Equal(1))
+		Expect(mango.Collate(b, a)).To(Equal(-1))
+		Expect(mango.Collate(a, base.OrderedObject{{Name: "b", Value: 1.0}, {Name: "a", Value: 1.0}})).To(Equal(0))
+	})
+})
+
+func compare(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
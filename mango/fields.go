@@ -0,0 +1,142 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// Project returns a document with the fields of a document, for the
+// Fields of cloudantv1.PostFindOptions, or the document without fields.
+// The fields are dotted paths, like the fields of selectors.
+func Project(doc map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return doc
+	}
+	projected := make(map[string]interface{})
+	for _, field := range fields {
+		path := splitField(field)
+		value, found := fieldValue(doc, path)
+		if !found {
+			continue
+		}
+		parent := projected
+		for _, name := range path[:len(path)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[name] = child
+			}
+			parent = child
+		}
+		parent[path[len(path)-1]] = value
+	}
+	return projected
+}
+
+// ProjectDocuments returns new documents with the fields of documents,
+// or the documents without fields.
+func ProjectDocuments(docs []cloudantv1.Document, fields []string) ([]cloudantv1.Document, error) {
+	if len(fields) == 0 {
+		return docs, nil
+	}
+	projected := make([]cloudantv1.Document, 0, len(docs))
+	for i := range docs {
+		m, err := documentMap(&docs[i])
+		if err != nil {
+			return nil, err
+		}
+		doc, err := newDocument(Project(m, fields))
+		if err != nil {
+			return nil, err
+		}
+		projected = append(projected, *doc)
+	}
+	return projected, nil
+}
+
+// splitField splits a field into the names of its path, at the dots that are not escaped.
+func splitField(field string) []string {
+	var path []string
+	var name strings.Builder
+	for i := 0; i < len(field); i++ {
+		switch {
+		case field[i] == '\\' && i+1 < len(field) && field[i+1] == '.':
+			name.WriteByte('.')
+			i++
+		case field[i] == '.':
+			path = append(path, name.String())
+			name.Reset()
+		default:
+			name.WriteByte(field[i])
+		}
+	}
+	return append(path, name.String())
+}
+
+// fieldValue returns the value of the field path in a value,
+// with array elements addressed by their index.
+func fieldValue(value interface{}, path []string) (interface{}, bool) {
+	for _, name := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[name]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// documentMap returns the JSON form of a document.
+func documentMap(doc *cloudantv1.Document) (map[string]interface{}, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(b, &m)
+	return m, err
+}
+
+// newDocument returns the document of a JSON form.
+func newDocument(m map[string]interface{}) (*cloudantv1.Document, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	var doc *cloudantv1.Document
+	err = cloudantv1.UnmarshalDocument(raw, &doc)
+	return doc, err
+}
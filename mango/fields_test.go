@@ -0,0 +1,52 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"github.com/IBM/cloudant-go-sdk/mango"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fields tests`, func() {
+	It(`Projects fields`, func() {
+		projected := mango.Project(parse(doc), []string{"_id", "address.city", "roles.0.name", "missing", "address.post\\.code"})
+		Expect(projected).To(Equal(parse(`{
+			"_id": "doc1",
+			"address": {"city": "Bristol", "post.code": "BS1"},
+			"roles": {"0": {"name": "admin"}}
+		}`)))
+	})
+
+	It(`Returns documents without fields`, func() {
+		Expect(mango.Project(parse(doc), nil)).To(Equal(parse(doc)))
+	})
+
+	It(`Projects the fields of documents`, func() {
+		docs := documents(
+			`{"_id": "a", "_rev": "1-a", "name": "Alice", "age": 42}`,
+			`{"_id": "b", "_rev": "1-b", "name": "Bob"}`,
+		)
+		projected, err := mango.ProjectDocuments(docs, []string{"_id", "age"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(projected)).To(Equal([]string{"a", "b"}))
+		Expect(projected[0].Rev).To(BeNil())
+		Expect(projected[0].GetProperties()).To(Equal(map[string]interface{}{"age": 42.0}))
+		Expect(projected[1].GetProperties()).To(BeEmpty())
+		Expect(*docs[0].Rev).To(Equal("1-a"))
+	})
+})
@@ -0,0 +1,65 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango
+
+import (
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// defaultLimit is the default limit of the _find endpoint.
+const defaultLimit = 25
+
+// Find returns the documents of a slice selected by the Selector, Sort,
+// Skip, Limit and Fields of the options, like the _find endpoint
+// returns the documents of a database. Like the endpoint, the limit is 25
+// by default and only the documents with the fields of the sort are
+// returned. Without a sort, the documents keep the order of the slice.
+// The other options are ignored.
+func Find(docs []cloudantv1.Document, o *cloudantv1.PostFindOptions) ([]cloudantv1.Document, error) {
+	selector, err := Compile(o.Selector)
+	if err != nil {
+		return nil, err
+	}
+	sort, err := CompileSort(o.Sort)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := documentEntries(docs)
+	if err != nil {
+		return nil, err
+	}
+	var selected []documentEntry
+	for _, entry := range entries {
+		if selector.Matches(entry.m) && sort.HasFields(entry.m) {
+			selected = append(selected, entry)
+		}
+	}
+	if o.Sort != nil {
+		sortEntries(selected, sort)
+	}
+
+	start := int64(0)
+	if o.Skip != nil {
+		start = min(max(*o.Skip, 0), int64(len(selected)))
+	}
+	limit := int64(defaultLimit)
+	if o.Limit != nil {
+		limit = max(*o.Limit, 0)
+	}
+	end := min(start+limit, int64(len(selected)))
+	return ProjectDocuments(entryDocuments(selected[start:end]), o.Fields)
+}
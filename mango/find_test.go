@@ -0,0 +1,86 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"strconv"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/mango"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Find tests`, func() {
+	var docs []cloudantv1.Document
+
+	BeforeEach(func() {
+		docs = nil
+		for i, age := range []int{35, 12, 58, 41, 27, 63} {
+			docs = append(docs, documents(`{"_id": "`+string(rune('a'+i))+`", "type": "user", "age": `+strconv.Itoa(age)+`}`)...)
+		}
+		docs = append(docs, documents(`{"_id": "z", "type": "admin"}`)...)
+	})
+
+	It(`Finds documents like the _find endpoint`, func() {
+		o := &cloudantv1.PostFindOptions{
+			Selector: map[string]interface{}{"type": "user", "age": map[string]interface{}{"$gte": 18}},
+			Sort:     []map[string]string{{"age": "desc"}},
+			Skip:     core.Int64Ptr(1),
+			Limit:    core.Int64Ptr(2),
+			Fields:   []string{"_id"},
+		}
+		found, err := mango.Find(docs, o)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(found)).To(Equal([]string{"c", "d"}))
+		Expect(found[0].GetProperties()).To(BeEmpty())
+	})
+
+	It(`Keeps the order of the documents without a sort`, func() {
+		found, err := mango.Find(docs, &cloudantv1.PostFindOptions{Selector: map[string]interface{}{}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(found)).To(Equal([]string{"a", "b", "c", "d", "e", "f", "z"}))
+	})
+
+	It(`Only returns the documents with the fields of the sort`, func() {
+		o := &cloudantv1.PostFindOptions{
+			Selector: map[string]interface{}{},
+			Sort:     []map[string]string{{"age": "asc"}},
+		}
+		found, err := mango.Find(docs, o)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(found)).To(Equal([]string{"b", "e", "a", "d", "c", "f"}))
+	})
+
+	It(`Limits the documents to 25 by default`, func() {
+		var many []cloudantv1.Document
+		for i := range 30 {
+			many = append(many, documents(`{"_id": "doc`+strconv.Itoa(i)+`"}`)...)
+		}
+		found, err := mango.Find(many, &cloudantv1.PostFindOptions{Selector: map[string]interface{}{}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(found).To(HaveLen(25))
+	})
+
+	It(`Returns selector and sort errors`, func() {
+		_, err := mango.Find(docs, &cloudantv1.PostFindOptions{Selector: map[string]interface{}{"age": map[string]interface{}{"$foo": 1}}})
+		Expect(err).Should(MatchError(mango.ErrInvalidSelector))
+		_, err = mango.Find(docs, &cloudantv1.PostFindOptions{Selector: map[string]interface{}{}, Sort: []map[string]string{{"age": "up"}}})
+		Expect(err).Should(MatchError(mango.ErrInvalidSort))
+	})
+})
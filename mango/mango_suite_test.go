@@ -0,0 +1,60 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMango(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mango Suite")
+}
+
+// parse returns the JSON form of a JSON object.
+func parse(s string) map[string]interface{} {
+	var m map[string]interface{}
+	Expect(json.Unmarshal([]byte(s), &m)).To(Succeed())
+	return m
+}
+
+// documents returns the documents of JSON objects.
+func documents(objects ...string) []cloudantv1.Document {
+	docs := make([]cloudantv1.Document, 0, len(objects))
+	for _, object := range objects {
+		var m map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(object), &m)).To(Succeed())
+		var doc *cloudantv1.Document
+		Expect(cloudantv1.UnmarshalDocument(m, &doc)).To(Succeed())
+		docs = append(docs, *doc)
+	}
+	return docs
+}
+
+// ids returns the IDs of documents.
+func ids(docs []cloudantv1.Document) []string {
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, *doc.ID)
+	}
+	return ids
+}
@@ -0,0 +1,484 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mango evaluates Mango selectors, field projections and sorts
+// locally, without a Cloudant server.
+//
+// A selector is compiled once and matched against documents, for example
+// to filter documents already in memory or to check the documents of the
+// changes feed with the semantics of the _selector filter:
+//
+//	s, err := mango.Compile(map[string]interface{}{
+//		"type": "user",
+//		"age":  map[string]interface{}{"$gte": 18},
+//	})
+//	users, err := s.Filter(docs)
+//
+// The selectors support all the combination and condition operators of
+// CouchDB, except $text which requires a search index, with the field path
+// and type semantics of CouchDB. Values are compared with the view collation,
+// where strings approximate the ICU collation, except the values of the _id
+// field which are compared by their bytes.
+package mango
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// ErrInvalidSelector is wrapped by all errors returned from Compile.
+var ErrInvalidSelector = errors.New("invalid selector")
+
+// typeNames are the JSON type names accepted by the $type operator.
+var typeNames = []string{"null", "boolean", "number", "string", "array", "object"}
+
+// Selector is a compiled Mango selector.
+type Selector struct {
+	root *node
+}
+
+// node is a node of a compiled selector: a combination operator,
+// a condition operator or a field with the selector of its value.
+type node struct {
+	operator string
+	path     []string
+	children []*node
+	arg      interface{}
+	regex    *regexp.Regexp
+}
+
+// Compile compiles a Mango selector, for example the Selector of
+// cloudantv1.PostFindOptions or a selector built by the selector package.
+// The values of the selector are normalized to their JSON form.
+func Compile(selector map[string]interface{}) (*Selector, error) {
+	normalized, err := normalizeSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+	root, err := compileSelector(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+	return &Selector{root: root}, nil
+}
+
+// MustCompile is like Compile but panics if the selector is invalid.
+func MustCompile(selector map[string]interface{}) *Selector {
+	s, err := Compile(selector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Matches reports if a document matches the selector. The document
+// must have the JSON form of encoding/json, for example with float64
+// or json.Number numbers and []interface{} arrays. The float64 form
+// cannot tell 2.0 from 2, so $mod matches both unlike CouchDB,
+// which matches integers only; decode with UseNumber to tell them apart.
+func (s *Selector) Matches(doc map[string]interface{}) bool {
+	return s.root.match(doc, Collate)
+}
+
+// MatchesDocument reports if a document matches the selector.
+// The properties of a cloudantv1.Document have float64 numbers.
+func (s *Selector) MatchesDocument(doc *cloudantv1.Document) (bool, error) {
+	m, err := documentMap(doc)
+	if err != nil {
+		return false, err
+	}
+	return s.Matches(m), nil
+}
+
+// Filter returns the documents matching the selector, in order.
+func (s *Selector) Filter(docs []cloudantv1.Document) ([]cloudantv1.Document, error) {
+	var matches []cloudantv1.Document
+	for i := range docs {
+		match, err := s.MatchesDocument(&docs[i])
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matches = append(matches, docs[i])
+		}
+	}
+	return matches, nil
+}
+
+// normalizeSelector returns the JSON form of a selector.
+func normalizeSelector(selector map[string]interface{}) (map[string]interface{}, error) {
+	if selector == nil {
+		return nil, errors.New("selector must not be nil")
+	}
+	b, err := json.Marshal(selector)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var normalized map[string]interface{}
+	err = decoder.Decode(&normalized)
+	return normalized, err
+}
+
+// compileSelector compiles the members of a selector into an $and node.
+func compileSelector(selector map[string]interface{}) (*node, error) {
+	n := &node{operator: "$and"}
+	for _, name := range slices.Sorted(maps.Keys(selector)) {
+		child, err := compileMember(name, selector[name])
+		if err != nil {
+			return nil, err
+		}
+		n.children = append(n.children, child)
+	}
+	return n, nil
+}
+
+func compileMember(name string, arg interface{}) (*node, error) {
+	switch name {
+	case "$and", "$or", "$nor":
+		args, ok := arg.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s requires an array of selectors", name)
+		}
+		n := &node{operator: name}
+		for _, a := range args {
+			s, ok := a.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s requires an array of selectors", name)
+			}
+			child, err := compileSelector(s)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, child)
+		}
+		return n, nil
+	case "$not":
+		s, ok := arg.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("$not requires a selector")
+		}
+		child, err := compileSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		return &node{operator: name, children: []*node{child}}, nil
+	}
+	if strings.HasPrefix(name, "$") {
+		return compileCondition(name, arg)
+	}
+
+	if name == "" {
+		return nil, errors.New("field name must not be empty")
+	}
+	n := &node{path: splitField(name)}
+	var child *node
+	var err error
+	if s, ok := arg.(map[string]interface{}); ok {
+		child, err = compileSelector(s)
+	} else {
+		child, err = compileCondition("$eq", arg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	n.children = []*node{child}
+	return n, nil
+}
+
+func compileCondition(operator string, arg interface{}) (*node, error) {
+	n := &node{operator: operator, arg: arg}
+	switch operator {
+	case "$eq", "$ne", "$lt", "$lte", "$gt", "$gte":
+	case "$exists":
+		if _, ok := arg.(bool); !ok {
+			return nil, errors.New("$exists requires a boolean")
+		}
+	case "$type":
+		if name, ok := arg.(string); !ok || !slices.Contains(typeNames, name) {
+			return nil, fmt.Errorf("$type requires one of %s, got %v", strings.Join(typeNames, ", "), arg)
+		}
+	case "$in", "$nin", "$all":
+		if _, ok := arg.([]interface{}); !ok {
+			return nil, fmt.Errorf("%s requires an array", operator)
+		}
+	case "$size":
+		if !isInteger(arg) || number(arg) < 0 {
+			return nil, errors.New("$size requires a non-negative integer")
+		}
+	case "$mod":
+		args, ok := arg.([]interface{})
+		if !ok || len(args) != 2 || !isInteger(args[0]) || !isInteger(args[1]) {
+			return nil, errors.New("$mod requires an array of an integer divisor and remainder")
+		}
+		if number(args[0]) == 0 {
+			return nil, errors.New("$mod requires a non-zero divisor")
+		}
+	case "$regex":
+		pattern, ok := arg.(string)
+		if !ok {
+			return nil, errors.New("$regex requires a string")
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("$regex requires a valid regular expression: %w", err)
+		}
+		n.regex = re
+	case "$beginsWith":
+		if _, ok := arg.(string); !ok {
+			return nil, errors.New("$beginsWith requires a string")
+		}
+	case "$elemMatch", "$allMatch", "$keyMapMatch":
+		s, ok := arg.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s requires a selector", operator)
+		}
+		child, err := compileSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		n.arg = nil
+		n.children = []*node{child}
+	case "$text":
+		return nil, errors.New("$text requires a text index")
+	default:
+		return nil, fmt.Errorf("unknown operator %s", operator)
+	}
+	return n, nil
+}
+
+// isInteger reports if a value is an integer number. A json.Number is an
+// integer without a fraction or exponent, as in CouchDB, so 2.0 is not.
+func isInteger(v interface{}) bool {
+	switch v := v.(type) {
+	case json.Number:
+		return !strings.ContainsAny(v.String(), ".eE")
+	case float64:
+		return v == math.Trunc(v)
+	}
+	return false
+}
+
+// integer returns the exact value of a number isInteger accepts.
+func integer(v interface{}) *big.Int {
+	if n, ok := v.(json.Number); ok {
+		i, ok := new(big.Int).SetString(n.String(), 10)
+		if ok {
+			return i
+		}
+	}
+	i, _ := big.NewFloat(number(v)).Int(nil)
+	return i
+}
+
+// match reports if a value matches a node.
+func (n *node) match(value interface{}, compare func(a, b interface{}) int) bool {
+	switch n.operator {
+	case "":
+		fieldCompare := compare
+		if len(n.path) == 1 && n.path[0] == "_id" {
+			fieldCompare = compareRaw
+		}
+		field, found := fieldValue(value, n.path)
+		if !found {
+			return n.children[0].matchMissing()
+		}
+		return n.children[0].match(field, fieldCompare)
+	case "$and":
+		for _, child := range n.children {
+			if !child.match(value, compare) {
+				return false
+			}
+		}
+		return true
+	case "$or":
+		if len(n.children) == 0 {
+			return true
+		}
+		for _, child := range n.children {
+			if child.match(value, compare) {
+				return true
+			}
+		}
+		return false
+	case "$nor":
+		for _, child := range n.children {
+			if child.match(value, compare) {
+				return false
+			}
+		}
+		return true
+	case "$not":
+		return !n.children[0].match(value, compare)
+	}
+	return n.matchCondition(value, compare)
+}
+
+// matchMissing reports if a missing field matches a node. Only $exists false
+// and the combinations of nodes that don't need a value match a missing field.
+func (n *node) matchMissing() bool {
+	switch n.operator {
+	case "":
+		// a nested field of a missing field is missing too
+		return n.children[0].matchMissing()
+	case "$and":
+		for _, child := range n.children {
+			if !child.matchMissing() {
+				return false
+			}
+		}
+		return true
+	case "$or":
+		if len(n.children) == 0 {
+			return true
+		}
+		for _, child := range n.children {
+			if child.matchMissing() {
+				return true
+			}
+		}
+		return false
+	case "$nor":
+		for _, child := range n.children {
+			if child.matchMissing() {
+				return false
+			}
+		}
+		return true
+	case "$not":
+		return !n.children[0].matchMissing()
+	case "$exists":
+		return n.arg == false
+	}
+	return false
+}
+
+// matchCondition reports if a value matches a condition operator.
+func (n *node) matchCondition(value interface{}, compare func(a, b interface{}) int) bool {
+	switch n.operator {
+	case "$eq":
+		return compare(value, n.arg) == 0
+	case "$ne":
+		return compare(value, n.arg) != 0
+	case "$lt":
+		return compare(value, n.arg) < 0
+	case "$lte":
+		return compare(value, n.arg) <= 0
+	case "$gt":
+		return compare(value, n.arg) > 0
+	case "$gte":
+		return compare(value, n.arg) >= 0
+	case "$exists":
+		return n.arg == true
+	case "$type":
+		return n.arg == typeName(value)
+	case "$in", "$nin":
+		args := n.arg.([]interface{})
+		values, isArray := value.([]interface{})
+		if !isArray {
+			values = []interface{}{value}
+		}
+		in := slices.ContainsFunc(args, func(a interface{}) bool {
+			return slices.ContainsFunc(values, func(v interface{}) bool { return compare(v, a) == 0 })
+		})
+		return in == (n.operator == "$in")
+	case "$all":
+		args := n.arg.([]interface{})
+		values, ok := value.([]interface{})
+		if !ok || len(args) == 0 {
+			return false
+		}
+		for _, a := range args {
+			if !slices.ContainsFunc(values, func(v interface{}) bool { return compare(v, a) == 0 }) {
+				return false
+			}
+		}
+		return true
+	case "$size":
+		values, ok := value.([]interface{})
+		return ok && float64(len(values)) == number(n.arg)
+	case "$mod":
+		args := n.arg.([]interface{})
+		if !isInteger(value) {
+			return false
+		}
+		// integers are compared exactly, as json.Number values
+		// above 2^53 lose precision as float64
+		remainder := new(big.Int).Rem(integer(value), integer(args[0]))
+		return remainder.Cmp(integer(args[1])) == 0
+	case "$regex":
+		s, ok := value.(string)
+		return ok && n.regex.MatchString(s)
+	case "$beginsWith":
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, n.arg.(string))
+	case "$elemMatch", "$allMatch":
+		values, ok := value.([]interface{})
+		if !ok || (n.operator == "$allMatch" && len(values) == 0) {
+			return false
+		}
+		for _, v := range values {
+			match := n.children[0].match(v, compare)
+			if match && n.operator == "$elemMatch" {
+				return true
+			}
+			if !match && n.operator == "$allMatch" {
+				return false
+			}
+		}
+		return n.operator == "$allMatch"
+	case "$keyMapMatch":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key := range object {
+			if n.children[0].match(key, compare) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// typeName returns the Mango type name of a JSON value.
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	}
+	return "object"
+}
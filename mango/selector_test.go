@@ -0,0 +1,234 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/IBM/cloudant-go-sdk/mango"
+	"github.com/IBM/cloudant-go-sdk/selector"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const doc = `{
+	"_id": "doc1",
+	"type": "user",
+	"name": "Alice",
+	"age": 42,
+	"score": 7.5,
+	"active": true,
+	"manager": null,
+	"tags": ["admin", "dev"],
+	"scores": [3, 5, 8],
+	"address": {"city": "Bristol", "post.code": "BS1"},
+	"roles": [{"name": "admin", "level": 2}, {"name": "dev", "level": 1}],
+	"settings": {"theme": "dark", "lang": "en"}
+}`
+
+var _ = Describe(`Selector tests`, func() {
+	It(`Matches documents`, func() {
+		for _, c := range []struct {
+			selector string
+			match    bool
+		}{
+			{`{"type": "user"}`, true},
+			{`{"type": "admin"}`, false},
+			{`{"type": "user", "age": 42}`, true},
+			{`{"manager": {"$eq": null}}`, true},
+			{`{"age": {"$ne": 41}}`, true},
+			{`{"missing": {"$ne": 1}}`, false},
+			{`{"age": {"$lt": 43}}`, true},
+			{`{"age": {"$lte": 42}}`, true},
+			{`{"age": {"$gt": 42}}`, false},
+			{`{"age": {"$gte": 42}}`, true},
+			{`{"name": {"$gt": 100}}`, true},
+			{`{"name": {"$lt": "bob"}}`, true},
+			{`{"manager": {"$exists": true}}`, true},
+			{`{"missing": {"$exists": false}}`, true},
+			{`{"age": {"$exists": false}}`, false},
+			{`{"age": {"$type": "number"}}`, true},
+			{`{"address": {"$type": "object"}}`, true},
+			{`{"manager": {"$type": "null"}}`, true},
+			{`{"type": {"$in": ["admin", "user"]}}`, true},
+			{`{"tags": {"$in": ["ops", "dev"]}}`, true},
+			{`{"type": {"$nin": ["admin"]}}`, true},
+			{`{"tags": {"$nin": ["dev"]}}`, false},
+			{`{"tags": {"$all": ["dev", "admin"]}}`, true},
+			{`{"tags": {"$all": ["dev", "ops"]}}`, false},
+			{`{"tags": {"$all": []}}`, false},
+			{`{"tags": {"$size": 2}}`, true},
+			{`{"name": {"$size": 5}}`, false},
+			{`{"age": {"$mod": [5, 2]}}`, true},
+			{`{"score": {"$mod": [5, 2]}}`, false},
+			{`{"name": {"$regex": "^A.*e$"}}`, true},
+			{`{"age": {"$regex": "4"}}`, false},
+			{`{"name": {"$beginsWith": "Al"}}`, true},
+			{`{"roles": {"$elemMatch": {"name": "dev", "level": {"$lt": 2}}}}`, true},
+			{`{"scores": {"$elemMatch": {"$gt": 7}}}`, true},
+			{`{"roles": {"$elemMatch": {"name": "dev", "level": 2}}}`, false},
+			{`{"scores": {"$allMatch": {"$gt": 2}}}`, true},
+			{`{"scores": {"$allMatch": {"$gt": 3}}}`, false},
+			{`{"settings": {"$keyMapMatch": {"$eq": "lang"}}}`, true},
+			{`{"settings": {"$keyMapMatch": {"$eq": "font"}}}`, false},
+			{`{"$and": [{"type": "user"}, {"age": {"$gt": 40}}]}`, true},
+			{`{"$or": [{"type": "admin"}, {"age": {"$gt": 40}}]}`, true},
+			{`{"$or": [{"type": "admin"}, {"age": {"$gt": 50}}]}`, false},
+			{`{"$nor": [{"type": "admin"}, {"age": {"$gt": 50}}]}`, true},
+			{`{"$not": {"type": "admin"}}`, true},
+			{`{"missing": {"$not": {"$eq": 1}}}`, true},
+			{`{"address.city": "Bristol"}`, true},
+			{`{"address": {"city": {"$beginsWith": "Bri"}}}`, true},
+			{`{"name.first": {"$exists": false}}`, true},
+			{`{"address.post\\.code": "BS1"}`, true},
+			{`{"roles.1.name": "dev"}`, true},
+			{`{"roles.2.name": {"$exists": true}}`, false},
+			{`{"address": {"$eq": {"post.code": "BS1", "city": "Bristol"}}}`, true},
+			{`{"scores": [3, 5, 8]}`, true},
+			{`{"_id": {"$gt": "DOC9"}}`, true},
+			{`{"name": {"$gt": "ALICE"}}`, false},
+			{`{}`, true},
+		} {
+			compiled, err := mango.Compile(parse(c.selector))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(compiled.Matches(parse(doc))).To(Equal(c.match), c.selector)
+		}
+	})
+
+	It(`Compiles selectors with Go values`, func() {
+		compiled, err := mango.Compile(map[string]interface{}{
+			"age":  map[string]interface{}{"$in": []int{41, 42}},
+			"tags": map[string]interface{}{"$all": []string{"dev"}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(compiled.Matches(parse(doc))).To(BeTrue())
+	})
+
+	It(`Compiles built selectors`, func() {
+		s, err := selector.And(
+			selector.Field("type", selector.Eq("user")),
+			selector.Field("roles", selector.ElemMatch(selector.Field("level", selector.Gte(2)))),
+		).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mango.MustCompile(s).Matches(parse(doc))).To(BeTrue())
+	})
+
+	It(`Rejects invalid selectors`, func() {
+		for _, c := range []struct {
+			selector string
+			message  string
+		}{
+			{`{"age": {"$foo": 1}}`, "unknown operator $foo"},
+			{`{"$and": {"age": 1}}`, "$and requires an array of selectors"},
+			{`{"$not": [1]}`, "$not requires a selector"},
+			{`{"age": {"$exists": 1}}`, "$exists requires a boolean"},
+			{`{"age": {"$type": "integer"}}`, "$type requires one of"},
+			{`{"age": {"$in": 1}}`, "$in requires an array"},
+			{`{"tags": {"$size": 1.5}}`, "$size requires a non-negative integer"},
+			{`{"age": {"$mod": [2]}}`, "$mod requires an array"},
+			{`{"age": {"$mod": [0, 1]}}`, "$mod requires a non-zero divisor"},
+			{`{"name": {"$regex": "("}}`, "$regex requires a valid regular expression"},
+			{`{"tags": {"$elemMatch": "dev"}}`, "$elemMatch requires a selector"},
+			{`{"$text": "dev"}`, "$text requires a text index"},
+			{`{"": 1}`, "field name must not be empty"},
+		} {
+			_, err := mango.Compile(parse(c.selector))
+			Expect(err).Should(MatchError(mango.ErrInvalidSelector), c.selector)
+			Expect(err.Error()).To(ContainSubstring(c.message))
+		}
+	})
+
+	It(`Rejects a nil selector`, func() {
+		_, err := mango.Compile(nil)
+		Expect(err).Should(MatchError(mango.ErrInvalidSelector))
+		Expect(func() { mango.MustCompile(nil) }).To(Panic())
+	})
+
+	It(`Filters documents`, func() {
+		docs := documents(
+			`{"_id": "a", "type": "user", "age": 17}`,
+			`{"_id": "b", "type": "user", "age": 42}`,
+			`{"_id": "c", "type": "admin", "age": 51}`,
+			`{"_id": "d", "type": "user", "age": 65}`,
+		)
+		compiled := mango.MustCompile(map[string]interface{}{
+			"type": "user",
+			"age":  map[string]interface{}{"$gte": 18},
+		})
+		users, err := compiled.Filter(docs)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(users)).To(Equal([]string{"b", "d"}))
+		Expect(users[0].GetProperty("age")).To(BeEquivalentTo(42))
+
+		match, err := compiled.MatchesDocument(&docs[0])
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(match).To(BeFalse())
+	})
+
+	It(`Matches $mod with integers only`, func() {
+		compiled := mango.MustCompile(parse(`{"age": {"$mod": [5, 2]}}`))
+		for _, c := range []struct {
+			doc   string
+			match bool
+		}{
+			{`{"age": 7}`, true},
+			{`{"age": 12}`, true},
+			{`{"age": -3}`, false},
+			{`{"age": 8}`, false},
+			{`{"age": 2.0}`, false},
+			{`{"age": 2e0}`, false},
+			{`{"age": "2"}`, false},
+		} {
+			decoder := json.NewDecoder(strings.NewReader(c.doc))
+			decoder.UseNumber()
+			var m map[string]interface{}
+			Expect(decoder.Decode(&m)).To(Succeed())
+			Expect(compiled.Matches(m)).To(Equal(c.match), c.doc)
+		}
+
+		_, err := mango.Compile(map[string]interface{}{
+			"age": map[string]interface{}{"$mod": []interface{}{5, json.Number("2.0")}},
+		})
+		Expect(err).Should(MatchError(mango.ErrInvalidSelector))
+	})
+
+	It(`Matches $mod with integers above 2^53 exactly`, func() {
+		for _, c := range []struct {
+			selector string
+			doc      string
+			match    bool
+		}{
+			{`{"id": {"$mod": [2, 1]}}`, `{"id": 9007199254740993}`, true},
+			{`{"id": {"$mod": [2, 0]}}`, `{"id": 9007199254740993}`, false},
+			{`{"id": {"$mod": [10, 7]}}`, `{"id": 123456789012345678901234567}`, true},
+			{`{"id": {"$mod": [9007199254740993, 1]}}`, `{"id": 9007199254740994}`, true},
+			{`{"id": {"$mod": [2, -1]}}`, `{"id": -9007199254740993}`, true},
+		} {
+			var selector, m map[string]interface{}
+			for _, v := range []struct {
+				data string
+				dest *map[string]interface{}
+			}{{c.selector, &selector}, {c.doc, &m}} {
+				decoder := json.NewDecoder(strings.NewReader(v.data))
+				decoder.UseNumber()
+				Expect(decoder.Decode(v.dest)).To(Succeed())
+			}
+			Expect(mango.MustCompile(selector).Matches(m)).To(Equal(c.match), c.selector+" "+c.doc)
+		}
+	})
+})
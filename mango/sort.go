@@ -0,0 +1,141 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// ErrInvalidSort is wrapped by all errors returned from CompileSort.
+var ErrInvalidSort = errors.New("invalid sort")
+
+// Sort is a compiled Mango sort.
+type Sort struct {
+	fields     [][]string
+	descending bool
+}
+
+// CompileSort compiles a Mango sort, for example the Sort of
+// cloudantv1.PostFindOptions, with one field and its asc or desc direction
+// per element. All fields must be sorted in the same direction, as required
+// by CouchDB. An empty sort sorts by _id.
+func CompileSort(sort []map[string]string) (*Sort, error) {
+	s := &Sort{}
+	directions := make(map[string]bool)
+	for _, element := range sort {
+		if len(element) != 1 {
+			return nil, fmt.Errorf("%w: each sort element requires a single field", ErrInvalidSort)
+		}
+		for field, direction := range element {
+			if direction != "asc" && direction != "desc" {
+				return nil, fmt.Errorf("%w: invalid direction %q of %s", ErrInvalidSort, direction, field)
+			}
+			s.fields = append(s.fields, splitField(field))
+			directions[direction] = true
+		}
+	}
+	if len(directions) > 1 {
+		return nil, fmt.Errorf("%w: all fields must be sorted in the same direction", ErrInvalidSort)
+	}
+	if len(s.fields) == 0 {
+		s.fields = [][]string{{"_id"}}
+	}
+	s.descending = directions["desc"]
+	return s, nil
+}
+
+// Compare compares two documents in the order of the sort.
+// A missing field sorts before all values.
+func (s *Sort) Compare(a, b map[string]interface{}) int {
+	for _, field := range s.fields {
+		va, aok := fieldValue(a, field)
+		vb, bok := fieldValue(b, field)
+		c := compareInts(boolRank(aok), boolRank(bok))
+		if c == 0 {
+			c = Collate(va, vb)
+		}
+		if c != 0 {
+			if s.descending {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
+
+// HasFields reports if a document has all the fields of the sort.
+// CouchDB only returns the documents with the fields of the sort,
+// as they are the only documents in the index used for the sort.
+func (s *Sort) HasFields(doc map[string]interface{}) bool {
+	return !slices.ContainsFunc(s.fields, func(field []string) bool {
+		_, found := fieldValue(doc, field)
+		return !found
+	})
+}
+
+// SortDocuments returns the documents in the order of the sort.
+// The sort is stable.
+func (s *Sort) SortDocuments(docs []cloudantv1.Document) ([]cloudantv1.Document, error) {
+	entries, err := documentEntries(docs)
+	if err != nil {
+		return nil, err
+	}
+	sortEntries(entries, s)
+	return entryDocuments(entries), nil
+}
+
+func sortEntries(entries []documentEntry, s *Sort) {
+	slices.SortStableFunc(entries, func(a, b documentEntry) int { return s.Compare(a.m, b.m) })
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// documentEntry is a document with its JSON form.
+type documentEntry struct {
+	doc cloudantv1.Document
+	m   map[string]interface{}
+}
+
+func documentEntries(docs []cloudantv1.Document) ([]documentEntry, error) {
+	entries := make([]documentEntry, 0, len(docs))
+	for _, doc := range docs {
+		m, err := documentMap(&doc)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, documentEntry{doc: doc, m: m})
+	}
+	return entries, nil
+}
+
+func entryDocuments(entries []documentEntry) []cloudantv1.Document {
+	docs := make([]cloudantv1.Document, 0, len(entries))
+	for _, entry := range entries {
+		docs = append(docs, entry.doc)
+	}
+	return docs
+}
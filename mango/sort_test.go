@@ -0,0 +1,76 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mango_test
+
+import (
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/mango"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Sort tests`, func() {
+	docs := documents(
+		`{"_id": "a", "name": "bob", "age": 30}`,
+		`{"_id": "b", "name": "Alice", "age": 30}`,
+		`{"_id": "c", "age": 25}`,
+		`{"_id": "d", "name": "alice", "age": 41}`,
+	)
+
+	It(`Sorts documents`, func() {
+		sort, err := mango.CompileSort([]map[string]string{{"age": "asc"}, {"name": "asc"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		sorted, err := sort.SortDocuments(docs)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(sorted)).To(Equal([]string{"c", "b", "a", "d"}))
+	})
+
+	It(`Sorts documents in descending order`, func() {
+		sort, err := mango.CompileSort([]map[string]string{{"name": "desc"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		sorted, err := sort.SortDocuments(docs)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(sorted)).To(Equal([]string{"a", "b", "d", "c"}))
+	})
+
+	It(`Sorts by _id by default`, func() {
+		sort, err := mango.CompileSort(nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		sorted, err := sort.SortDocuments([]cloudantv1.Document{docs[3], docs[0], docs[2], docs[1]})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids(sorted)).To(Equal([]string{"a", "b", "c", "d"}))
+	})
+
+	It(`Reports documents with the fields of the sort`, func() {
+		sort, err := mango.CompileSort([]map[string]string{{"name": "asc"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sort.HasFields(parse(`{"name": null}`))).To(BeTrue())
+		Expect(sort.HasFields(parse(`{"age": 1}`))).To(BeFalse())
+	})
+
+	It(`Rejects invalid sorts`, func() {
+		for _, s := range [][]map[string]string{
+			{{"age": "up"}},
+			{{"age": "asc", "name": "asc"}},
+			{{}},
+			{{"age": "asc"}, {"name": "desc"}},
+		} {
+			_, err := mango.CompileSort(s)
+			Expect(err).Should(MatchError(mango.ErrInvalidSort), "%v", s)
+		}
+	})
+})
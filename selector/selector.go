@@ -0,0 +1,341 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package selector builds Mango selectors from typed operator constructors.
+//
+// The built selectors are plain map[string]interface{} values accepted by
+// PostFindOptions.Selector, PostExplainOptions.Selector,
+// PostChangesOptions.Selector and IndexDefinition.PartialFilterSelector,
+// so they can be used with pagination and the changes follower as well.
+//
+//	s, err := selector.And(
+//		selector.Field("type", selector.Eq("user")),
+//		selector.Field("age", selector.Gte(18), selector.Lt(65)),
+//		selector.Field("tags", selector.ElemMatch(selector.Value(selector.Eq("admin")))),
+//	).Build()
+package selector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSelector is wrapped by all errors returned from Build.
+var ErrInvalidSelector = errors.New("invalid selector")
+
+// TypeName is a JSON type name accepted by the $type operator.
+type TypeName string
+
+// JSON type names accepted by the $type operator.
+const (
+	TypeNull    TypeName = "null"
+	TypeBoolean TypeName = "boolean"
+	TypeNumber  TypeName = "number"
+	TypeString  TypeName = "string"
+	TypeArray   TypeName = "array"
+	TypeObject  TypeName = "object"
+)
+
+// Condition is a condition operator applied to a field, for example $gt.
+type Condition struct {
+	operator string
+	value    interface{}
+	err      error
+}
+
+// Selector is a Mango selector expression.
+// The zero value is an empty selector matching all documents.
+type Selector struct {
+	expr map[string]interface{}
+	errs []error
+}
+
+// Field returns a selector applying all the conditions to a field.
+// The field is a dotted path, see Path for fields containing dots.
+// Without conditions the field must be equal to null.
+func Field(field string, conditions ...Condition) Selector {
+	s := Selector{expr: make(map[string]interface{})}
+	if field == "" {
+		s.errs = append(s.errs, fmt.Errorf("field name must not be empty"))
+		return s
+	}
+	value, errs := conditionsValue(field, conditions)
+	s.expr[field] = value
+	s.errs = append(s.errs, errs...)
+	return s
+}
+
+// Value returns a selector applying the conditions to the value itself
+// rather than to a field, for matching array elements of scalar values
+// with ElemMatch or AllMatch.
+func Value(conditions ...Condition) Selector {
+	s := Selector{expr: make(map[string]interface{})}
+	if len(conditions) == 0 {
+		s.errs = append(s.errs, fmt.Errorf("at least one condition is required for a value selector"))
+		return s
+	}
+	value, errs := conditionsValue("", conditions)
+	s.expr = value.(map[string]interface{})
+	s.errs = append(s.errs, errs...)
+	return s
+}
+
+// And returns a selector matching documents matching all the selectors.
+func And(selectors ...Selector) Selector {
+	return combination("$and", selectors)
+}
+
+// Or returns a selector matching documents matching any of the selectors.
+func Or(selectors ...Selector) Selector {
+	return combination("$or", selectors)
+}
+
+// Nor returns a selector matching documents matching none of the selectors.
+func Nor(selectors ...Selector) Selector {
+	return combination("$nor", selectors)
+}
+
+// Not returns a selector matching documents not matching the selector.
+func Not(selector Selector) Selector {
+	s := Selector{expr: make(map[string]interface{})}
+	s.expr["$not"] = selector.expr
+	s.errs = append(s.errs, selector.errs...)
+	if len(selector.expr) == 0 {
+		s.errs = append(s.errs, fmt.Errorf("$not requires a non-empty selector"))
+	}
+	return s
+}
+
+// Eq matches values equal to v.
+func Eq(v interface{}) Condition { return valueCondition("$eq", v) }
+
+// Ne matches values not equal to v.
+func Ne(v interface{}) Condition { return valueCondition("$ne", v) }
+
+// Lt matches values less than v.
+func Lt(v interface{}) Condition { return valueCondition("$lt", v) }
+
+// Lte matches values less than or equal to v.
+func Lte(v interface{}) Condition { return valueCondition("$lte", v) }
+
+// Gt matches values greater than v.
+func Gt(v interface{}) Condition { return valueCondition("$gt", v) }
+
+// Gte matches values greater than or equal to v.
+func Gte(v interface{}) Condition { return valueCondition("$gte", v) }
+
+// Exists matches fields that exist, or do not exist when exists is false.
+func Exists(exists bool) Condition { return Condition{operator: "$exists", value: exists} }
+
+// Type matches values of the given JSON type.
+func Type(t TypeName) Condition {
+	c := Condition{operator: "$type", value: string(t)}
+	switch t {
+	case TypeNull, TypeBoolean, TypeNumber, TypeString, TypeArray, TypeObject:
+	default:
+		c.err = fmt.Errorf("$type requires one of null, boolean, number, string, array or object, got %q", t)
+	}
+	return c
+}
+
+// In matches values equal to any of the values.
+func In(values ...interface{}) Condition { return listCondition("$in", values) }
+
+// Nin matches values equal to none of the values.
+func Nin(values ...interface{}) Condition { return listCondition("$nin", values) }
+
+// All matches arrays containing all the values.
+func All(values ...interface{}) Condition { return listCondition("$all", values) }
+
+// Size matches arrays of the given length.
+func Size(length int) Condition {
+	c := Condition{operator: "$size", value: length}
+	if length < 0 {
+		c.err = fmt.Errorf("$size requires a non-negative length, got %d", length)
+	}
+	return c
+}
+
+// Mod matches numbers with the given remainder after division by divisor.
+func Mod(divisor, remainder int64) Condition {
+	c := Condition{operator: "$mod", value: []int64{divisor, remainder}}
+	if divisor == 0 {
+		c.err = fmt.Errorf("$mod requires a non-zero divisor")
+	}
+	return c
+}
+
+// Regex matches strings against a PCRE regular expression.
+func Regex(pattern string) Condition {
+	c := Condition{operator: "$regex", value: pattern}
+	if pattern == "" {
+		c.err = fmt.Errorf("$regex requires a non-empty pattern")
+	}
+	return c
+}
+
+// BeginsWith matches strings starting with the prefix.
+func BeginsWith(prefix string) Condition {
+	c := Condition{operator: "$beginsWith", value: prefix}
+	if prefix == "" {
+		c.err = fmt.Errorf("$beginsWith requires a non-empty prefix")
+	}
+	return c
+}
+
+// ElemMatch matches arrays with at least one element matching the selector.
+func ElemMatch(selector Selector) Condition { return selectorCondition("$elemMatch", selector) }
+
+// AllMatch matches arrays with all elements matching the selector.
+func AllMatch(selector Selector) Condition { return selectorCondition("$allMatch", selector) }
+
+// KeyMapMatch matches objects with at least one key matching the selector.
+func KeyMapMatch(selector Selector) Condition { return selectorCondition("$keyMapMatch", selector) }
+
+// Negate matches values not matching all the conditions, that is
+// the field level $not operator.
+func Negate(conditions ...Condition) Condition {
+	c := Condition{operator: "$not"}
+	if len(conditions) == 0 {
+		c.err = fmt.Errorf("$not requires at least one condition")
+		return c
+	}
+	value, errs := conditionsValue("$not", conditions)
+	c.value = value
+	c.err = errors.Join(errs...)
+	return c
+}
+
+// Path joins field names into a dotted field path,
+// escaping any dots in the names.
+func Path(names ...string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = strings.ReplaceAll(name, ".", `\.`)
+	}
+	return strings.Join(escaped, ".")
+}
+
+// Build validates the selector and returns it as a map for use in options.
+func (s Selector) Build() (map[string]interface{}, error) {
+	if len(s.errs) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSelector, errors.Join(s.errs...))
+	}
+	if s.expr == nil {
+		return make(map[string]interface{}), nil
+	}
+	if _, err := json.Marshal(s.expr); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+	return s.expr, nil
+}
+
+// MustBuild is like Build but panics if the selector is invalid.
+func (s Selector) MustBuild() map[string]interface{} {
+	m, err := s.Build()
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// String returns the JSON representation of the selector.
+func (s Selector) String() string {
+	b, err := json.Marshal(s.expr)
+	if err != nil {
+		return fmt.Sprintf("%v", s.expr)
+	}
+	return string(b)
+}
+
+func valueCondition(operator string, v interface{}) Condition {
+	c := Condition{operator: operator, value: v}
+	if _, err := json.Marshal(v); err != nil {
+		c.err = fmt.Errorf("%s requires a JSON value: %w", operator, err)
+	}
+	return c
+}
+
+func listCondition(operator string, values []interface{}) Condition {
+	if values == nil {
+		values = []interface{}{}
+	}
+	c := Condition{operator: operator, value: values}
+	if operator == "$all" && len(values) == 0 {
+		c.err = fmt.Errorf("$all requires at least one value")
+		return c
+	}
+	if _, err := json.Marshal(values); err != nil {
+		c.err = fmt.Errorf("%s requires JSON values: %w", operator, err)
+	}
+	return c
+}
+
+func selectorCondition(operator string, selector Selector) Condition {
+	c := Condition{operator: operator, value: selector.expr}
+	if len(selector.expr) == 0 {
+		c.err = fmt.Errorf("%s requires a non-empty selector", operator)
+	}
+	if len(selector.errs) > 0 {
+		c.err = errors.Join(append([]error{c.err}, selector.errs...)...)
+	}
+	return c
+}
+
+// conditionsValue merges conditions into a single operator object.
+// A field without conditions matches null.
+func conditionsValue(context string, conditions []Condition) (interface{}, []error) {
+	var errs []error
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+	ops := make(map[string]interface{}, len(conditions))
+	for _, c := range conditions {
+		if c.operator == "" {
+			errs = append(errs, fmt.Errorf("uninitialized condition for %q", context))
+			continue
+		}
+		if c.err != nil {
+			errs = append(errs, c.err)
+		}
+		if _, ok := ops[c.operator]; ok {
+			errs = append(errs, fmt.Errorf("duplicate %s condition for %q", c.operator, context))
+			continue
+		}
+		ops[c.operator] = c.value
+	}
+	return ops, errs
+}
+
+func combination(operator string, selectors []Selector) Selector {
+	s := Selector{expr: make(map[string]interface{})}
+	if len(selectors) == 0 {
+		s.errs = append(s.errs, fmt.Errorf("%s requires at least one selector", operator))
+	}
+	exprs := make([]interface{}, 0, len(selectors))
+	for _, selector := range selectors {
+		s.errs = append(s.errs, selector.errs...)
+		if len(selector.expr) == 0 {
+			s.errs = append(s.errs, fmt.Errorf("%s requires non-empty selectors", operator))
+			continue
+		}
+		exprs = append(exprs, selector.expr)
+	}
+	s.expr[operator] = exprs
+	return s
+}
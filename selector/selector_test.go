@@ -0,0 +1,135 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package selector_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/selector"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// toJSON builds a selector and returns its JSON form
+func toJSON(s selector.Selector) string {
+	m, err := s.Build()
+	Expect(err).ShouldNot(HaveOccurred())
+	b, err := json.Marshal(m)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
+var _ = Describe(`Selector builder tests`, func() {
+	It(`Builds field conditions`, func() {
+		Expect(toJSON(selector.Field("type", selector.Eq("user")))).To(MatchJSON(`{"type": {"$eq": "user"}}`))
+		Expect(toJSON(selector.Field("age", selector.Gte(18), selector.Lt(65)))).To(MatchJSON(`{"age": {"$gte": 18, "$lt": 65}}`))
+		Expect(toJSON(selector.Field("deleted"))).To(MatchJSON(`{"deleted": null}`))
+		Expect(toJSON(selector.Field("a", selector.Ne(1), selector.Lte(2), selector.Gt(0)))).To(MatchJSON(`{"a": {"$ne": 1, "$lte": 2, "$gt": 0}}`))
+		Expect(toJSON(selector.Field("a", selector.Exists(false)))).To(MatchJSON(`{"a": {"$exists": false}}`))
+		Expect(toJSON(selector.Field("a", selector.Type(selector.TypeString)))).To(MatchJSON(`{"a": {"$type": "string"}}`))
+		Expect(toJSON(selector.Field("a", selector.In("x", "y"), selector.Nin()))).To(MatchJSON(`{"a": {"$in": ["x", "y"], "$nin": []}}`))
+		Expect(toJSON(selector.Field("a", selector.All(1, 2), selector.Size(2)))).To(MatchJSON(`{"a": {"$all": [1, 2], "$size": 2}}`))
+		Expect(toJSON(selector.Field("a", selector.Mod(4, 1)))).To(MatchJSON(`{"a": {"$mod": [4, 1]}}`))
+		Expect(toJSON(selector.Field("a", selector.Regex("^A"), selector.BeginsWith("Al")))).To(MatchJSON(`{"a": {"$regex": "^A", "$beginsWith": "Al"}}`))
+		Expect(toJSON(selector.Field("a", selector.Negate(selector.Eq(1))))).To(MatchJSON(`{"a": {"$not": {"$eq": 1}}}`))
+	})
+
+	It(`Builds combination operators`, func() {
+		s := selector.And(
+			selector.Field("type", selector.Eq("user")),
+			selector.Or(selector.Field("a", selector.Eq(1)), selector.Field("b", selector.Eq(2))),
+			selector.Nor(selector.Field("c", selector.Eq(3))),
+			selector.Not(selector.Field("d", selector.Eq(4))),
+		)
+		Expect(toJSON(s)).To(MatchJSON(`{"$and": [
+			{"type": {"$eq": "user"}},
+			{"$or": [{"a": {"$eq": 1}}, {"b": {"$eq": 2}}]},
+			{"$nor": [{"c": {"$eq": 3}}]},
+			{"$not": {"d": {"$eq": 4}}}
+		]}`))
+	})
+
+	It(`Builds array and object matchers`, func() {
+		s := selector.And(
+			selector.Field("genre", selector.ElemMatch(selector.Value(selector.Eq("Horror")))),
+			selector.Field("cast", selector.AllMatch(selector.Field("name", selector.Exists(true)))),
+			selector.Field("ratings", selector.KeyMapMatch(selector.Value(selector.Gt(5)))),
+		)
+		Expect(toJSON(s)).To(MatchJSON(`{"$and": [
+			{"genre": {"$elemMatch": {"$eq": "Horror"}}},
+			{"cast": {"$allMatch": {"name": {"$exists": true}}}},
+			{"ratings": {"$keyMapMatch": {"$gt": 5}}}
+		]}`))
+	})
+
+	It(`Escapes dotted field names`, func() {
+		Expect(selector.Path("address", "post.code")).To(Equal(`address.post\.code`))
+		Expect(toJSON(selector.Field(selector.Path("a", "b"), selector.Eq(1)))).To(MatchJSON(`{"a.b": {"$eq": 1}}`))
+	})
+
+	It(`Builds an empty selector from the zero value`, func() {
+		m, err := selector.Selector{}.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(m).To(BeEmpty())
+	})
+
+	It(`Validates operator arity and types`, func() {
+		invalid := []selector.Selector{
+			selector.Field(""),
+			selector.Field("a", selector.Eq(1), selector.Eq(2)),
+			selector.Field("a", selector.Type("date")),
+			selector.Field("a", selector.Size(-1)),
+			selector.Field("a", selector.Mod(0, 1)),
+			selector.Field("a", selector.Regex("")),
+			selector.Field("a", selector.BeginsWith("")),
+			selector.Field("a", selector.All()),
+			selector.Field("a", selector.Eq(func() {})),
+			selector.Field("a", selector.In(make(chan int))),
+			selector.Field("a", selector.ElemMatch(selector.Selector{})),
+			selector.Field("a", selector.AllMatch(selector.Field("b", selector.Size(-1)))),
+			selector.Field("a", selector.Negate()),
+			selector.Field("a", selector.Condition{}),
+			selector.Value(),
+			selector.And(),
+			selector.Or(selector.Field("a", selector.Eq(1)), selector.Selector{}),
+			selector.Nor(selector.Field("a", selector.Mod(0, 0))),
+			selector.Not(selector.Selector{}),
+		}
+		for _, s := range invalid {
+			_, err := s.Build()
+			Expect(err).Should(MatchError(selector.ErrInvalidSelector))
+		}
+		Expect(func() { selector.Field("a", selector.Size(-1)).MustBuild() }).To(Panic())
+	})
+
+	It(`Builds selectors usable in options`, func() {
+		service, err := cloudantv1.NewCloudantV1(&cloudantv1.CloudantV1Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		s := selector.Field("type", selector.Eq("user"))
+		findOptions := service.NewPostFindOptions("db", s.MustBuild())
+		Expect(findOptions.Selector).To(HaveKey("type"))
+
+		index := &cloudantv1.IndexDefinition{PartialFilterSelector: s.MustBuild()}
+		Expect(index.PartialFilterSelector).To(HaveKey("type"))
+		Expect(s.String()).To(MatchJSON(`{"type": {"$eq": "user"}}`))
+	})
+})
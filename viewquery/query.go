@@ -0,0 +1,285 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package viewquery builds MapReduce view queries with complex keys
+// and decodes the values of the built-in reducers.
+//
+// The built queries are PostViewOptions, so they can be used with
+// PostView and with view pagination as well.
+//
+//	opts, err := viewquery.New("orders", "reports", "by_customer_date").
+//		Prefix("customer1", 2025).
+//		GroupLevel(3).
+//		Build()
+package viewquery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/cloudant-go-sdk/base"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// ErrInvalidQuery is wrapped by all errors returned from Build.
+var ErrInvalidQuery = errors.New("invalid view query")
+
+// Key is a complex view key, a JSON array of values.
+type Key []interface{}
+
+// Object is a view key object that serializes its members in order.
+// The keys of view result rows decode objects as Object.
+type Object = base.OrderedObject
+
+// Member is a member of an Object.
+type Member = base.ObjectMember
+
+// Bound is a value collating before or after other values of a key.
+type Bound int
+
+const (
+	// Min is null, collating before all other values.
+	Min Bound = iota
+
+	// Max is the empty object {}, collating after all other values
+	// except non-empty objects.
+	Max
+)
+
+// MarshalJSON returns the JSON value of the bound.
+func (b Bound) MarshalJSON() ([]byte, error) {
+	if b == Max {
+		return []byte("{}"), nil
+	}
+	return []byte("null"), nil
+}
+
+// Query is a builder of a view query.
+// The lower and upper bounds of the keys are swapped into
+// the start and end keys of a descending query when building.
+type Query struct {
+	options  cloudantv1.PostViewOptions
+	lower    interface{}
+	upper    interface{}
+	hasLower bool
+	hasUpper bool
+	hasKey   bool
+	hasKeys  bool
+	errs     []error
+}
+
+// New returns a query of the view of the design document in the database.
+func New(db, ddoc, view string) *Query {
+	q := &Query{}
+	q.options.SetDb(db)
+	q.options.SetDdoc(ddoc)
+	q.options.SetView(view)
+	return q
+}
+
+// Key sets the key of the rows.
+func (q *Query) Key(key interface{}) *Query {
+	q.hasKey = true
+	q.options.Key = key
+	return q
+}
+
+// Keys sets the keys of the rows.
+func (q *Query) Keys(keys ...interface{}) *Query {
+	q.hasKeys = true
+	q.options.Keys = keys
+	return q
+}
+
+// Range sets the lower and upper bounds of the keys, including both.
+func (q *Query) Range(lower, upper interface{}) *Query {
+	return q.From(lower).To(upper)
+}
+
+// From sets the lower bound of the keys.
+func (q *Query) From(lower interface{}) *Query {
+	q.lower, q.hasLower = lower, true
+	return q
+}
+
+// To sets the upper bound of the keys.
+func (q *Query) To(upper interface{}) *Query {
+	q.upper, q.hasUpper = upper, true
+	return q
+}
+
+// Prefix sets the bounds to the array keys starting with the prefix values.
+func (q *Query) Prefix(prefix ...interface{}) *Query {
+	if len(prefix) == 0 {
+		q.errs = append(q.errs, errors.New("prefix requires at least one value"))
+		return q
+	}
+	lower := append(Key{}, prefix...)
+	upper := append(append(Key{}, prefix...), Max)
+	return q.Range(lower, upper)
+}
+
+// InclusiveEnd sets whether the end key of the query is included,
+// the end is the lower bound of a descending query.
+func (q *Query) InclusiveEnd(inclusive bool) *Query {
+	q.options.SetInclusiveEnd(inclusive)
+	return q
+}
+
+// Descending returns the rows in descending key order.
+func (q *Query) Descending() *Query {
+	q.options.SetDescending(true)
+	return q
+}
+
+// Reduce sets whether the reduce function of the view is used.
+func (q *Query) Reduce(reduce bool) *Query {
+	q.options.SetReduce(reduce)
+	return q
+}
+
+// Group groups the reduced rows by key.
+func (q *Query) Group() *Query {
+	q.options.SetGroup(true)
+	return q
+}
+
+// GroupLevel groups the reduced rows by the first level values of array keys.
+func (q *Query) GroupLevel(level int64) *Query {
+	if level < 1 {
+		q.errs = append(q.errs, fmt.Errorf("group level %d must be at least 1", level))
+	}
+	q.options.SetGroupLevel(level)
+	return q
+}
+
+// IncludeDocs includes the documents of the rows.
+func (q *Query) IncludeDocs() *Query {
+	q.options.SetIncludeDocs(true)
+	return q
+}
+
+// Limit sets the maximum number of rows.
+func (q *Query) Limit(limit int64) *Query {
+	if limit < 0 {
+		q.errs = append(q.errs, fmt.Errorf("limit %d must not be negative", limit))
+	}
+	q.options.SetLimit(limit)
+	return q
+}
+
+// Skip sets the number of rows to skip.
+func (q *Query) Skip(skip int64) *Query {
+	if skip < 0 {
+		q.errs = append(q.errs, fmt.Errorf("skip %d must not be negative", skip))
+	}
+	q.options.SetSkip(skip)
+	return q
+}
+
+// Build returns the view options of the query.
+func (q *Query) Build() (*cloudantv1.PostViewOptions, error) {
+	errs := append([]error{}, q.errs...)
+	if q.hasKey && (q.hasKeys || q.hasLower || q.hasUpper) {
+		errs = append(errs, errors.New("key cannot be combined with keys or a key range"))
+	}
+	if q.hasKeys && (q.hasLower || q.hasUpper) {
+		errs = append(errs, errors.New("keys cannot be combined with a key range"))
+	}
+	// null keys are omitted from the request
+	if q.hasKey && keyValue(q.options.Key) == nil {
+		errs = append(errs, errors.New("key cannot be null, use keys instead"))
+	}
+	if q.hasUpper && keyValue(q.upper) == nil {
+		errs = append(errs, errors.New("upper bound cannot be null"))
+	}
+	reduced := q.options.Reduce == nil || *q.options.Reduce
+	if !reduced && (q.options.Group != nil || q.options.GroupLevel != nil) {
+		errs = append(errs, errors.New("group and group level require reduce"))
+	}
+	if q.options.Reduce != nil && *q.options.Reduce && q.options.IncludeDocs != nil {
+		errs = append(errs, errors.New("include docs requires no reduce"))
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, errors.Join(errs...))
+	}
+
+	opts := q.options
+	start, end := q.lower, q.upper
+	hasStart, hasEnd := q.hasLower, q.hasUpper
+	if opts.Descending != nil && *opts.Descending {
+		start, end = end, start
+		hasStart, hasEnd = hasEnd, hasStart
+	}
+	if hasStart {
+		opts.StartKey = keyValue(start)
+	}
+	if hasEnd {
+		opts.EndKey = keyValue(end)
+	}
+	if opts.Key != nil {
+		opts.Key = keyValue(opts.Key)
+	}
+	if opts.Keys != nil {
+		keys := make([]interface{}, len(opts.Keys))
+		for i, k := range opts.Keys {
+			keys[i] = keyValue(k)
+		}
+		opts.Keys = keys
+	}
+	for _, key := range []interface{}{opts.StartKey, opts.EndKey, opts.Key, opts.Keys} {
+		if _, err := json.Marshal(key); err != nil {
+			return nil, fmt.Errorf("%w: keys require JSON values: %w", ErrInvalidQuery, err)
+		}
+	}
+	return &opts, nil
+}
+
+// MustBuild is like Build but panics if the query is invalid.
+func (q *Query) MustBuild() *cloudantv1.PostViewOptions {
+	opts, err := q.Build()
+	if err != nil {
+		panic(err)
+	}
+	return opts
+}
+
+// keyValue returns the key with bounds replaced by their JSON values.
+func keyValue(key interface{}) interface{} {
+	switch k := key.(type) {
+	case Bound:
+		if k == Max {
+			return map[string]interface{}{}
+		}
+		return nil
+	case Key:
+		values := make([]interface{}, len(k))
+		for i, v := range k {
+			values[i] = keyValue(v)
+		}
+		return values
+	case []interface{}:
+		return keyValue(Key(k))
+	case Object:
+		members := make(Object, len(k))
+		for i, m := range k {
+			members[i] = Member{Name: m.Name, Value: keyValue(m.Value)}
+		}
+		return members
+	}
+	return key
+}
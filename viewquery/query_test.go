@@ -0,0 +1,116 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package viewquery_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/cloudant-go-sdk/viewquery"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func toJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
+var _ = Describe(`View query builder`, func() {
+	It(`Builds the view of the design document`, func() {
+		opts := viewquery.New("db", "ddoc", "view").Limit(10).Skip(5).IncludeDocs().Reduce(false).MustBuild()
+		Expect(*opts.Db).To(Equal("db"))
+		Expect(*opts.Ddoc).To(Equal("ddoc"))
+		Expect(*opts.View).To(Equal("view"))
+		Expect(*opts.Limit).To(BeEquivalentTo(10))
+		Expect(*opts.Skip).To(BeEquivalentTo(5))
+		Expect(*opts.IncludeDocs).To(BeTrue())
+		Expect(*opts.Reduce).To(BeFalse())
+	})
+
+	It(`Builds prefix queries of array keys`, func() {
+		opts, err := viewquery.New("db", "ddoc", "view").Prefix("a", 2025).GroupLevel(3).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(toJSON(opts.StartKey)).To(Equal(`["a",2025]`))
+		Expect(toJSON(opts.EndKey)).To(Equal(`["a",2025,{}]`))
+		Expect(*opts.GroupLevel).To(BeEquivalentTo(3))
+	})
+
+	It(`Swaps the bounds of descending queries`, func() {
+		opts, err := viewquery.New("db", "ddoc", "view").Prefix("a").Descending().Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(toJSON(opts.StartKey)).To(Equal(`["a",{}]`))
+		Expect(toJSON(opts.EndKey)).To(Equal(`["a"]`))
+		Expect(*opts.Descending).To(BeTrue())
+	})
+
+	It(`Replaces bounds with their JSON values`, func() {
+		opts, err := viewquery.New("db", "ddoc", "view").
+			Range(viewquery.Key{"a", viewquery.Min}, viewquery.Key{"b", viewquery.Max}).
+			Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(opts.StartKey).To(Equal([]interface{}{"a", nil}))
+		Expect(opts.EndKey).To(Equal([]interface{}{"b", map[string]interface{}{}}))
+		Expect(toJSON(viewquery.Key{viewquery.Min, viewquery.Max})).To(Equal(`[null,{}]`))
+
+		opts, err = viewquery.New("db", "ddoc", "view").From(viewquery.Min).To(viewquery.Max).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(opts.StartKey).To(BeNil())
+		Expect(opts.EndKey).To(Equal(map[string]interface{}{}))
+	})
+
+	It(`Builds object keys with ordered members`, func() {
+		opts, err := viewquery.New("db", "ddoc", "view").
+			Range(
+				viewquery.Object{{Name: "type", Value: "order"}, {Name: "date", Value: viewquery.Min}},
+				viewquery.Object{{Name: "type", Value: "order"}, {Name: "date", Value: viewquery.Max}},
+			).
+			Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(toJSON(opts.StartKey)).To(Equal(`{"type":"order","date":null}`))
+		Expect(toJSON(opts.EndKey)).To(Equal(`{"type":"order","date":{}}`))
+	})
+
+	It(`Builds keys queries`, func() {
+		opts, err := viewquery.New("db", "ddoc", "view").Keys(viewquery.Key{"a", 1}, nil).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(toJSON(opts.Keys)).To(Equal(`[["a",1],null]`))
+
+		opts, err = viewquery.New("db", "ddoc", "view").Key(viewquery.Key{"a", viewquery.Max}).Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(toJSON(opts.Key)).To(Equal(`["a",{}]`))
+	})
+
+	It(`Returns errors for invalid queries`, func() {
+		for _, q := range []*viewquery.Query{
+			viewquery.New("db", "ddoc", "view").Key("a").Range("a", "b"),
+			viewquery.New("db", "ddoc", "view").Keys("a").From("a"),
+			viewquery.New("db", "ddoc", "view").Key(nil),
+			viewquery.New("db", "ddoc", "view").To(viewquery.Min),
+			viewquery.New("db", "ddoc", "view").Prefix(),
+			viewquery.New("db", "ddoc", "view").Reduce(false).GroupLevel(2),
+			viewquery.New("db", "ddoc", "view").Reduce(true).IncludeDocs(),
+			viewquery.New("db", "ddoc", "view").GroupLevel(0),
+			viewquery.New("db", "ddoc", "view").Limit(-1),
+			viewquery.New("db", "ddoc", "view").From(func() {}),
+		} {
+			_, err := q.Build()
+			Expect(err).To(MatchError(viewquery.ErrInvalidQuery))
+		}
+		Expect(func() { viewquery.New("db", "ddoc", "view").Skip(-1).MustBuild() }).To(Panic())
+	})
+})
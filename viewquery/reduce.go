@@ -0,0 +1,85 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package viewquery
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+// Stats is the value of the _stats built-in reducer.
+type Stats struct {
+	Sum    float64 `json:"sum"`
+	Count  int64   `json:"count"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Sumsqr float64 `json:"sumsqr"`
+}
+
+// DecodeValue decodes the value of a row into T.
+func DecodeValue[T any](row cloudantv1.ViewResultRow) (T, error) {
+	return decode[T]("value", row.Value)
+}
+
+// DecodeKey decodes the key of a row into T,
+// for example a complex key into a struct or slice.
+func DecodeKey[T any](row cloudantv1.ViewResultRow) (T, error) {
+	return decode[T]("key", row.Key)
+}
+
+// Sum returns the value of a row reduced by _sum with numeric values.
+func Sum(row cloudantv1.ViewResultRow) (float64, error) {
+	return DecodeValue[float64](row)
+}
+
+// SumArray returns the value of a row reduced by _sum with arrays of numbers,
+// the sums of the values at each index.
+func SumArray(row cloudantv1.ViewResultRow) ([]float64, error) {
+	return DecodeValue[[]float64](row)
+}
+
+// Count returns the value of a row reduced by _count.
+func Count(row cloudantv1.ViewResultRow) (int64, error) {
+	return DecodeValue[int64](row)
+}
+
+// StatsValue returns the value of a row reduced by _stats.
+func StatsValue(row cloudantv1.ViewResultRow) (Stats, error) {
+	return DecodeValue[Stats](row)
+}
+
+// ApproxCountDistinct returns the value of a row reduced by _approx_count_distinct.
+func ApproxCountDistinct(row cloudantv1.ViewResultRow) (int64, error) {
+	return DecodeValue[int64](row)
+}
+
+func decode[T any](name string, v interface{}) (T, error) {
+	var result T
+	b, err := json.Marshal(v)
+	if err != nil {
+		return result, fmt.Errorf("cannot encode the %s: %w", name, err)
+	}
+	if string(b) == "null" {
+		return result, fmt.Errorf("the %s is null", name)
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return result, fmt.Errorf("cannot decode the %s %s as %T: %w", name, b, result, err)
+	}
+	return result, nil
+}
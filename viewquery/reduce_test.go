@@ -0,0 +1,77 @@
+/**
+ * © Copyright IBM Corporation 2026. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package viewquery_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/cloudant-go-sdk/viewquery"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func reducedRow(body string) cloudantv1.ViewResultRow {
+	m := make(map[string]json.RawMessage)
+	Expect(json.Unmarshal([]byte(body), &m)).To(Succeed())
+	var row *cloudantv1.ViewResultRow
+	Expect(cloudantv1.UnmarshalViewResultRow(m, &row)).To(Succeed())
+	return *row
+}
+
+var _ = Describe(`Reduced values`, func() {
+	It(`Decodes _sum values`, func() {
+		sum, err := viewquery.Sum(reducedRow(`{"key":null,"value":12.5}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sum).To(Equal(12.5))
+
+		sums, err := viewquery.SumArray(reducedRow(`{"key":["a"],"value":[1,2,3]}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sums).To(Equal([]float64{1, 2, 3}))
+	})
+
+	It(`Decodes _count and _approx_count_distinct values`, func() {
+		count, err := viewquery.Count(reducedRow(`{"key":"a","value":42}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count).To(BeEquivalentTo(42))
+
+		distinct, err := viewquery.ApproxCountDistinct(reducedRow(`{"key":null,"value":7}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(distinct).To(BeEquivalentTo(7))
+	})
+
+	It(`Decodes _stats values`, func() {
+		stats, err := viewquery.StatsValue(reducedRow(`{"key":null,"value":{"sum":10,"count":4,"min":1,"max":4,"sumsqr":30}}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stats).To(Equal(viewquery.Stats{Sum: 10, Count: 4, Min: 1, Max: 4, Sumsqr: 30}))
+	})
+
+	It(`Decodes complex keys`, func() {
+		key, err := viewquery.DecodeKey[[]interface{}](reducedRow(`{"key":["a",2025],"value":1}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(key).To(Equal([]interface{}{"a", float64(2025)}))
+	})
+
+	It(`Returns errors for values of other reducers`, func() {
+		_, err := viewquery.Count(reducedRow(`{"key":null,"value":{"sum":1}}`))
+		Expect(err).Should(HaveOccurred())
+		_, err = viewquery.Sum(reducedRow(`{"key":null,"value":null}`))
+		Expect(err).Should(HaveOccurred())
+		_, err = viewquery.Count(reducedRow(`{"key":null,"value":1.5}`))
+		Expect(err).Should(HaveOccurred())
+	})
+})